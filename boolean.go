@@ -0,0 +1,102 @@
+package livr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// booleanOptions - names of "boolean" options with lists of truthy and falsy
+// values, e.g. {"boolean": {"truthy": ["y"], "falsy": ["n"]}}.
+var booleanOptions = map[string]bool{"truthy": true, "falsy": true}
+
+var (
+	defaultTruthy = []interface{}{"true", "1", "on", "yes"}
+	defaultFalsy  = []interface{}{"false", "0", "off", "no"}
+)
+
+// boolean - make sure that validated value is boolean, bools are returned as
+// is, truthy and falsy strings and numbers are converted to bool. Strings are
+// compared case-insensitive.
+func boolean(args ...interface{}) Validation {
+	truthy, falsy := defaultTruthy, defaultFalsy
+	if opts, ok := firstArg(args...).(Dictionary); ok && isOptions(opts, booleanOptions) {
+		if v, ok := opts["truthy"].([]interface{}); ok {
+			truthy = v
+		}
+		if v, ok := opts["falsy"].([]interface{}); ok {
+			falsy = v
+		}
+	}
+
+	values := make(map[string]bool, len(truthy)+len(falsy))
+	for _, v := range falsy {
+		values[booleanKey(v)] = false
+	}
+	for _, v := range truthy {
+		values[booleanKey(v)] = true
+	}
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+		default:
+			if _, ok := numberValue(value); !ok {
+				return nil, errors.New("FORMAT_ERROR")
+			}
+		}
+
+		if b, ok := values[booleanKey(value)]; ok {
+			return b, nil
+		}
+
+		return nil, errors.New("NOT_BOOLEAN")
+	}
+}
+
+func booleanKey(value interface{}) string {
+	if f, ok := numberValue(value); ok {
+		return fmt.Sprint(f)
+	}
+	return strings.ToLower(strings.TrimSpace(fmt.Sprint(value)))
+}
+
+func isBool(value interface{}) bool {
+	_, ok := value.(bool)
+	return ok
+}
+
+// is - make sure that value is present and is exactly equal to specified
+// value, e.g. {"is": true} for accepted terms.
+func is(args ...interface{}) Validation {
+	allowed := firstArg(args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return nil, errors.New("REQUIRED")
+		}
+
+		switch value.(type) {
+		case string, bool:
+			if value != allowed {
+				return nil, errors.New("NOT_ALLOWED_VALUE")
+			}
+		default:
+			f, ok := numberValue(value)
+			if !ok {
+				return nil, errors.New("FORMAT_ERROR")
+			}
+			if a, ok := numberValue(allowed); !ok || a != f {
+				return nil, errors.New("NOT_ALLOWED_VALUE")
+			}
+		}
+
+		return value, nil
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/k33nice/go-livr"
+)
+
+// lintResult - machine-readable lint result of a single rules file.
+type lintResult struct {
+	Source string           `json:"source"`
+	Issues []livr.LintIssue `json:"issues"`
+}
+
+func lint(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("lint", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	aliasesPath := fs.String("aliases", "", "path to JSON file with a list of rule aliases")
+	asJSON := fs.Bool("json", false, "print one JSON result per rules file")
+	fs.Usage = func() {
+		fmt.Fprint(stderr, "Usage: livr lint [options] rules.json [...]\n\n")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	var aliases []livr.Alias
+	if *aliasesPath != "" {
+		if err := readJSON(*aliasesPath, &aliases); err != nil {
+			fmt.Fprintf(stderr, "livr: %v\n", err)
+			return exitRules
+		}
+	}
+
+	code := exitOK
+	for _, rulesPath := range fs.Args() {
+		var rules livr.Dictionary
+		if err := readJSON(rulesPath, &rules); err != nil {
+			fmt.Fprintf(stderr, "livr: %v\n", err)
+			code = exitRules
+			continue
+		}
+
+		issues, err := lintRules(rules, aliases)
+		if err != nil {
+			fmt.Fprintf(stderr, "livr: %s: %v\n", *aliasesPath, err)
+			return exitRules
+		}
+		if len(issues) > 0 {
+			code = exitRules
+		}
+
+		if *asJSON {
+			enc := json.NewEncoder(stdout)
+			enc.SetEscapeHTML(false)
+			if issues == nil {
+				issues = []livr.LintIssue{}
+			}
+			if err := enc.Encode(lintResult{Source: rulesPath, Issues: issues}); err != nil {
+				fmt.Fprintf(stderr, "livr: %v\n", err)
+				return exitInput
+			}
+			continue
+		}
+		for _, issue := range issues {
+			fmt.Fprintf(stdout, "%s: %s\n", rulesPath, issue)
+		}
+	}
+
+	return code
+}
+
+func lintRules(rules livr.Dictionary, aliases []livr.Alias) (issues []livr.LintIssue, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	v := livr.New(&livr.Options{LivrRules: rules})
+	for _, a := range aliases {
+		v.RegisterAliasedRule(a)
+	}
+
+	return v.Lint(), nil
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"valid.json": `{"name": "required"}`,
+		"rules.json": `{"name": "requried"}`,
+	})
+	defer os.RemoveAll(dir)
+
+	var out, errOut bytes.Buffer
+	if code := run([]string{"lint", filepath.Join(dir, "valid.json")}, nil, &out, &errOut); code != exitOK {
+		t.Fatalf("exit code %d, want %d, stderr: %s", code, exitOK, errOut.String())
+	}
+	if code := run([]string{"lint"}, nil, &out, &errOut); code != exitUsage {
+		t.Errorf("exit code %d, want %d", code, exitUsage)
+	}
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"lint", "--json", filepath.Join(dir, "rules.json")}, nil, &stdout, &stderr)
+	if code != exitRules {
+		t.Fatalf("exit code %d, want %d", code, exitRules)
+	}
+
+	var res lintResult
+	if err := json.Unmarshal(stdout.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Issues) != 1 || res.Issues[0].Code != "UNKNOWN_RULE" {
+		t.Errorf("unexpected issues %v", res.Issues)
+	}
+}
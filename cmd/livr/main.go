@@ -0,0 +1,74 @@
+// Command livr validates JSON documents against LIVR rules.
+//
+// Usage:
+//
+//	livr validate --rules rules.json [--aliases aliases.json] [--ndjson] [--json] [file ...]
+//	livr lint [--aliases aliases.json] [--json] rules.json [...]
+//
+// Documents are read from the given files, or from stdin when no file (or "-")
+// is given. With --ndjson every non-empty line of input is validated as a
+// separate document. Lint reports every problem found in rules files, such as
+// misspelled rule names or conflicting bounds.
+//
+// Exit codes:
+//
+//	0 - all documents are valid
+//	1 - at least one document is invalid
+//	2 - bad command line usage
+//	3 - rules or aliases are broken (or lint found problems)
+//	4 - input can't be read or is not a JSON object
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+)
+
+const (
+	exitOK = iota
+	exitInvalid
+	exitUsage
+	exitRules
+	exitInput
+)
+
+const usage = `Usage: livr <command> [options] [file ...]
+
+Commands:
+  validate  validate JSON documents against rules
+  lint      check rules files for problems
+  help      show this message
+
+Run "livr <command> -h" for command options.
+`
+
+func main() {
+	// Rule builders report broken rules with log.Panicf, the error is
+	// printed by the command itself.
+	log.SetOutput(ioutil.Discard)
+
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "validate":
+		return validate(args[1:], stdin, stdout, stderr)
+	case "lint":
+		return lint(args[1:], stdout, stderr)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, usage)
+		return exitOK
+	default:
+		fmt.Fprintf(stderr, "livr: unknown command %q\n\n%s", args[0], usage)
+		return exitUsage
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "livr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestRun(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"rules.json":   `{"name": "required", "age": "positive_integer"}`,
+		"broken.json":  `{"name": "no_such_rule"}`,
+		"aliases.json": `[{"name": "adult", "rules": {"number_between": [18, 150]}}]`,
+		"adult.json":   `{"age": ["required", "adult"]}`,
+		"valid.json":   `{"name": "John", "age": 30}`,
+		"invalid.json": `{"age": -1}`,
+		"list.json":    `[1, 2]`,
+	})
+	defer os.RemoveAll(dir)
+	path := func(name string) string { return filepath.Join(dir, name) }
+
+	cases := []struct {
+		name   string
+		args   []string
+		stdin  string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{
+			name:  "stdin",
+			args:  []string{"validate", "--rules", path("rules.json")},
+			stdin: `{"name": "John", "age": 30, "extra": true}`,
+			code:  exitOK,
+			stdout: `{
+  "age": 30,
+  "name": "John"
+}
+`,
+		},
+		{
+			name:   "invalid stdin",
+			args:   []string{"validate", "--rules", path("rules.json"), "-"},
+			stdin:  `{"age": "x"}`,
+			code:   exitInvalid,
+			stderr: "<stdin>: invalid\n{\n  \"age\": \"NOT_POSITIVE_INTEGER\",\n  \"name\": \"REQUIRED\"\n}\n",
+		},
+		{
+			name:  "ndjson",
+			args:  []string{"validate", "--rules", path("rules.json"), "--ndjson", "--json"},
+			stdin: "{\"name\": \"John\"}\n\n{\"age\": 1}\n",
+			code:  exitInvalid,
+			stdout: `{"source":"<stdin>","line":1,"valid":true,"output":{"name":"John"}}
+{"source":"<stdin>","line":3,"valid":false,"errors":{"name":"REQUIRED"}}
+`,
+		},
+		{
+			name: "multiple files",
+			args: []string{"validate", "--rules", path("rules.json"), "--json", path("valid.json"), path("invalid.json")},
+			code: exitInvalid,
+			stdout: `{"source":"` + path("valid.json") + `","valid":true,"output":{"age":30,"name":"John"}}
+{"source":"` + path("invalid.json") + `","valid":false,"errors":{"age":"NOT_POSITIVE_INTEGER","name":"REQUIRED"}}
+`,
+		},
+		{
+			name:   "aliases",
+			args:   []string{"validate", "--rules", path("adult.json"), "--aliases", path("aliases.json"), "--json"},
+			stdin:  `{"age": 17}`,
+			code:   exitInvalid,
+			stdout: `{"source":"<stdin>","valid":false,"errors":{"age":"TOO_LOW"}}` + "\n",
+		},
+		{
+			name:   "broken rules",
+			args:   []string{"validate", "--rules", path("broken.json")},
+			code:   exitRules,
+			stderr: "livr: " + path("broken.json") + ": Rule no_such_rule not registered\n",
+		},
+		{
+			name:   "not an object",
+			args:   []string{"validate", "--rules", path("rules.json"), path("list.json")},
+			code:   exitInput,
+			stderr: "livr: " + path("list.json") + ": input must be a JSON object\n",
+		},
+		{
+			name: "missing rules",
+			args: []string{"validate"},
+			code: exitUsage,
+		},
+		{
+			name: "unknown command",
+			args: []string{"check"},
+			code: exitUsage,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(c.args, strings.NewReader(c.stdin), &stdout, &stderr)
+			if code != c.code {
+				t.Errorf("exit code %d, want %d, stderr: %s", code, c.code, stderr.String())
+			}
+			if c.stdout != "" && !strings.HasPrefix(stdout.String(), c.stdout) {
+				t.Errorf("stdout:\n%s\nwant:\n%s", stdout.String(), c.stdout)
+			}
+			if c.stderr != "" && stderr.String() != c.stderr {
+				t.Errorf("stderr:\n%s\nwant:\n%s", stderr.String(), c.stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/k33nice/go-livr"
+)
+
+// document - single JSON document read from input.
+type document struct {
+	source string
+	line   int
+	data   []byte
+}
+
+func (d document) name() string {
+	if d.line > 0 {
+		return fmt.Sprintf("%s:%d", d.source, d.line)
+	}
+	return d.source
+}
+
+// result - machine-readable validation result of a single document.
+type result struct {
+	Source string          `json:"source"`
+	Line   int             `json:"line,omitempty"`
+	Valid  bool            `json:"valid"`
+	Output livr.Dictionary `json:"output,omitempty"`
+	Errors interface{}     `json:"errors,omitempty"`
+}
+
+func validate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	rulesPath := fs.String("rules", "", "path to JSON file with LIVR rules (required)")
+	aliasesPath := fs.String("aliases", "", "path to JSON file with a list of rule aliases")
+	ndjson := fs.Bool("ndjson", false, "treat every input line as a separate document")
+	asJSON := fs.Bool("json", false, "print one JSON result per document")
+	fs.Usage = func() {
+		fmt.Fprint(stderr, "Usage: livr validate --rules rules.json [options] [file ...]\n\n")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if *rulesPath == "" {
+		fmt.Fprintln(stderr, "livr: --rules is required")
+		fs.Usage()
+		return exitUsage
+	}
+
+	validator, err := buildValidator(*rulesPath, *aliasesPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "livr: %v\n", err)
+		return exitRules
+	}
+
+	files := fs.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+
+	code := exitOK
+	for _, file := range files {
+		docs, err := readDocuments(file, stdin, *ndjson)
+		if err != nil {
+			fmt.Fprintf(stderr, "livr: %v\n", err)
+			code = exitInput
+			continue
+		}
+
+		for _, doc := range docs {
+			var data livr.Dictionary
+			if err := json.Unmarshal(doc.data, &data); err != nil || data == nil {
+				fmt.Fprintf(stderr, "livr: %s: input must be a JSON object\n", doc.name())
+				code = exitInput
+				continue
+			}
+
+			res, err := validateDocument(validator, doc, data)
+			if err != nil {
+				fmt.Fprintf(stderr, "livr: %s: %v\n", doc.name(), err)
+				return exitRules
+			}
+			if !res.Valid && code == exitOK {
+				code = exitInvalid
+			}
+
+			if err := printResult(stdout, stderr, doc, res, *asJSON); err != nil {
+				fmt.Fprintf(stderr, "livr: %v\n", err)
+				return exitInput
+			}
+		}
+	}
+
+	return code
+}
+
+func buildValidator(rulesPath, aliasesPath string) (v *livr.Validator, err error) {
+	var rules livr.Dictionary
+	if err := readJSON(rulesPath, &rules); err != nil {
+		return nil, err
+	}
+
+	var aliases []livr.Alias
+	if aliasesPath != "" {
+		if err := readJSON(aliasesPath, &aliases); err != nil {
+			return nil, err
+		}
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s: %v", aliasesPath, r)
+		}
+	}()
+
+	v = livr.New(&livr.Options{LivrRules: rules})
+	for _, a := range aliases {
+		v.RegisterAliasedRule(a)
+	}
+
+	if err := v.Prepare(); err != nil {
+		return nil, fmt.Errorf("%s: %v", rulesPath, err)
+	}
+
+	return v, nil
+}
+
+func validateDocument(v *livr.Validator, doc document, data livr.Dictionary) (res result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("rules failed on input: %v", r)
+		}
+	}()
+
+	res = result{Source: doc.source, Line: doc.line}
+
+	out, vErr := v.Validate(data)
+	if vErr != nil {
+		res.Errors = livr.ErrorCodes(v.Errors())
+		return res, nil
+	}
+
+	res.Valid = true
+	res.Output = out
+
+	return res, nil
+}
+
+func printResult(stdout, stderr io.Writer, doc document, res result, asJSON bool) error {
+	if asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetEscapeHTML(false)
+		return enc.Encode(res)
+	}
+
+	if res.Valid {
+		out, err := json.MarshalIndent(res.Output, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(stdout, "%s\n", out)
+		return err
+	}
+
+	errs, err := json.MarshalIndent(res.Errors, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(stderr, "%s: invalid\n%s\n", doc.name(), errs)
+	return err
+}
+
+func readJSON(path string, v interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: %v", path, err)
+	}
+	return nil
+}
+
+func readDocuments(file string, stdin io.Reader, ndjson bool) ([]document, error) {
+	source := file
+	var r io.Reader = stdin
+	if file == "-" {
+		source = "<stdin>"
+	} else {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	if !ndjson {
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", source, err)
+		}
+		return []document{{source: source, data: data}}, nil
+	}
+
+	var docs []document
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		docs = append(docs, document{source: source, line: line, data: append([]byte(nil), data...)})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %v", source, err)
+	}
+
+	return docs, nil
+}
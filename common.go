@@ -68,3 +68,123 @@ func required(...interface{}) Validation {
 		return value, nil
 	}
 }
+
+// requiredIf - checks that validated value exists and not empty when other
+// field is equal to one of specified values, e.g. {"required_if": ["country", "US"]}.
+func requiredIf(args ...interface{}) Validation {
+	field, values := conditionArgs(args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if !isEmpty(value) {
+			return value, nil
+		}
+		if other, ok := lookupField(scopeData(builders...), field); ok && matchesAny(other, values) {
+			return nil, errors.New("REQUIRED")
+		}
+		return value, nil
+	}
+}
+
+// requiredUnless - checks that validated value exists and not empty unless
+// other field is equal to one of specified values.
+func requiredUnless(args ...interface{}) Validation {
+	field, values := conditionArgs(args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if !isEmpty(value) {
+			return value, nil
+		}
+		if other, ok := lookupField(scopeData(builders...), field); !ok || !matchesAny(other, values) {
+			return nil, errors.New("REQUIRED")
+		}
+		return value, nil
+	}
+}
+
+// requiredWith - checks that validated value exists and not empty when any
+// of specified fields is present.
+func requiredWith(args ...interface{}) Validation {
+	fields := fieldArgs(args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if !isEmpty(value) {
+			return value, nil
+		}
+		data := scopeData(builders...)
+		for _, field := range fields {
+			if other, ok := lookupField(data, field); ok && !isEmpty(other) {
+				return nil, errors.New("REQUIRED")
+			}
+		}
+		return value, nil
+	}
+}
+
+// requiredWithout - checks that validated value exists and not empty when
+// any of specified fields is absent.
+func requiredWithout(args ...interface{}) Validation {
+	fields := fieldArgs(args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if !isEmpty(value) {
+			return value, nil
+		}
+		data := scopeData(builders...)
+		for _, field := range fields {
+			if other, ok := lookupField(data, field); !ok || isEmpty(other) {
+				return nil, errors.New("REQUIRED")
+			}
+		}
+		return value, nil
+	}
+}
+
+// scopeData - return object the validated value belongs to.
+func scopeData(builders ...interface{}) Dictionary {
+	if data, ok := firstArg(builders...).(Dictionary); ok {
+		return data
+	}
+	return nil
+}
+
+// conditionArgs - return field name and list of values from rule args.
+func conditionArgs(args ...interface{}) (string, []interface{}) {
+	field, _ := firstArg(args...).(string)
+	if len(args) == 0 {
+		return field, nil
+	}
+	return field, valueArgs(args[1:]...)
+}
+
+// fieldArgs - return field names from rule args.
+func fieldArgs(args ...interface{}) []string {
+	var fields []string
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case string:
+			fields = append(fields, v)
+		case []interface{}:
+			for _, f := range v {
+				if f, ok := f.(string); ok {
+					fields = append(fields, f)
+				}
+			}
+		}
+	}
+	return fields
+}
+
+// matchesAny - check that value is equal to one of values, values are compared
+// by type and value, so "1" is not equal to 1, see typedKey.
+func matchesAny(value interface{}, values []interface{}) bool {
+	key, ok := typedKey(value)
+	if !ok {
+		return false
+	}
+	for _, v := range values {
+		if k, ok := typedKey(v); ok && k == key {
+			return true
+		}
+	}
+	return false
+}
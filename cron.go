@@ -0,0 +1,116 @@
+package livr
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// cronField - allowed range and names of cron expression field.
+type cronField struct {
+	min, max int
+	names    []string
+}
+
+var (
+	cronSeconds  = cronField{min: 0, max: 59}
+	cronMinutes  = cronField{min: 0, max: 59}
+	cronHours    = cronField{min: 0, max: 23}
+	cronDays     = cronField{min: 1, max: 31}
+	cronMonths   = cronField{min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}}
+	cronWeekdays = cronField{min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}}
+)
+
+// cronMacros - predefined schedules.
+var cronMacros = map[string]bool{
+	"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true,
+	"@daily": true, "@midnight": true, "@hourly": true,
+}
+
+// cron - make sure that validated value is cron expression with 5 fields
+// (minute, hour, day of month, month, day of week), 6 fields with leading
+// seconds or macro like "@daily". Fields are "*", numbers, names of months
+// and week days, ranges, steps and lists, e.g. "*/15 9-17 * JAN-JUN MON,FRI".
+// Output has fields separated by single space.
+func cron(args ...interface{}) Validation {
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		fields := strings.Fields(v)
+		if len(fields) == 1 && cronMacros[strings.ToLower(fields[0])] {
+			return strings.ToLower(fields[0]), nil
+		}
+
+		layout := []cronField{cronMinutes, cronHours, cronDays, cronMonths, cronWeekdays}
+		switch len(fields) {
+		case 5:
+		case 6:
+			layout = append([]cronField{cronSeconds}, layout...)
+		default:
+			return nil, errors.New("NOT_CRON")
+		}
+
+		for i, field := range fields {
+			dayField := i >= len(fields)-3 && i != len(fields)-2
+			if field == "?" && dayField {
+				continue
+			}
+			if !layout[i].valid(field) {
+				return nil, errors.New("NOT_CRON")
+			}
+		}
+
+		return strings.Join(fields, " "), nil
+	}
+}
+
+// valid - check list of ranges with optional steps.
+func (f cronField) valid(field string) bool {
+	for _, part := range strings.Split(field, ",") {
+		rng, step, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n < 1 || n > f.max {
+				return false
+			}
+		}
+		if rng == "*" {
+			continue
+		}
+
+		from, to, isRange := strings.Cut(rng, "-")
+		lo, ok := f.value(from)
+		if !ok {
+			return false
+		}
+		if !isRange {
+			continue
+		}
+		hi, ok := f.value(to)
+		if !ok || hi < lo {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f cronField) value(s string) (int, bool) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return i + f.min, true
+		}
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max {
+		return 0, false
+	}
+	return n, true
+}
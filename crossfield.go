@@ -0,0 +1,196 @@
+package livr
+
+import (
+	"errors"
+	"strconv"
+)
+
+// greaterThanField - make sure that validated value is greater than validated
+// value of other field. With "inclusive" flag value can be equal to it.
+func greaterThanField(args ...interface{}) Validation {
+	field, _ := firstArg(args...).(string)
+	inclusive := hasFlag("inclusive", args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if isEmpty(value) {
+			return value, nil
+		}
+
+		n, errCode := toNumber(value)
+		if errCode != "" {
+			return nil, errors.New(errCode)
+		}
+		other, ok := validatedNumber(field, builders...)
+		if !ok {
+			return value, nil
+		}
+
+		if n < other || (n == other && !inclusive) {
+			return nil, errors.New("TOO_LOW")
+		}
+
+		return value, nil
+	}
+}
+
+// lessThanField - make sure that validated value is less than validated value
+// of other field. With "inclusive" flag value can be equal to it.
+func lessThanField(args ...interface{}) Validation {
+	field, _ := firstArg(args...).(string)
+	inclusive := hasFlag("inclusive", args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if isEmpty(value) {
+			return value, nil
+		}
+
+		n, errCode := toNumber(value)
+		if errCode != "" {
+			return nil, errors.New(errCode)
+		}
+		other, ok := validatedNumber(field, builders...)
+		if !ok {
+			return value, nil
+		}
+
+		if n > other || (n == other && !inclusive) {
+			return nil, errors.New("TOO_HIGH")
+		}
+
+		return value, nil
+	}
+}
+
+// notEqualToField - make sure that validated value differs from validated
+// value of other field, values are compared by type and value.
+func notEqualToField(args ...interface{}) Validation {
+	field, _ := firstArg(args...).(string)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if isEmpty(value) {
+			return value, nil
+		}
+
+		key, ok := typedKey(value)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		if other, ok := validatedField(field, builders...); ok {
+			if otherKey, ok := typedKey(other); ok && otherKey == key {
+				return nil, errors.New("FIELDS_EQUAL")
+			}
+		}
+
+		return value, nil
+	}
+}
+
+// afterField - make sure that validated date is after validated date of other
+// field. With "inclusive" flag dates can be equal.
+func afterField(args ...interface{}) Validation {
+	field, _ := firstArg(args...).(string)
+	inclusive := hasFlag("inclusive", args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if isEmpty(value) {
+			return value, nil
+		}
+
+		t, ok := parseDate(value)
+		if !ok {
+			return nil, errors.New("WRONG_DATE")
+		}
+		other, ok := validatedField(field, builders...)
+		if !ok {
+			return value, nil
+		}
+		otherT, ok := parseDate(other)
+		if !ok {
+			return value, nil
+		}
+
+		if t.Before(otherT) || (t.Equal(otherT) && !inclusive) {
+			return nil, errors.New("TOO_EARLY")
+		}
+
+		return value, nil
+	}
+}
+
+// beforeField - make sure that validated date is before validated date of
+// other field. With "inclusive" flag dates can be equal.
+func beforeField(args ...interface{}) Validation {
+	field, _ := firstArg(args...).(string)
+	inclusive := hasFlag("inclusive", args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if isEmpty(value) {
+			return value, nil
+		}
+
+		t, ok := parseDate(value)
+		if !ok {
+			return nil, errors.New("WRONG_DATE")
+		}
+		other, ok := validatedField(field, builders...)
+		if !ok {
+			return value, nil
+		}
+		otherT, ok := parseDate(other)
+		if !ok {
+			return value, nil
+		}
+
+		if t.After(otherT) || (t.Equal(otherT) && !inclusive) {
+			return nil, errors.New("TOO_LATE")
+		}
+
+		return value, nil
+	}
+}
+
+// validatedField - return validated value of field from the same object.
+// Field that is absent or failed validation is not returned.
+func validatedField(field string, builders ...interface{}) (interface{}, bool) {
+	if len(builders) < 2 {
+		return nil, false
+	}
+	results, ok := builders[1].(Dictionary)
+	if !ok {
+		return nil, false
+	}
+
+	value, ok := results[field]
+	if !ok || isEmpty(value) {
+		return nil, false
+	}
+	return value, true
+}
+
+func validatedNumber(field string, builders ...interface{}) (float64, bool) {
+	other, ok := validatedField(field, builders...)
+	if !ok {
+		return 0, false
+	}
+	n, errCode := toNumber(other)
+	return n, errCode == ""
+}
+
+// toNumber - convert number or numeric string to float64, returns error code
+// for any other value.
+func toNumber(value interface{}) (float64, string) {
+	if f, ok := numberValue(value); ok {
+		return f, ""
+	}
+
+	switch v := value.(type) {
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f, ""
+		}
+		return 0, "NOT_NUMBER"
+	default:
+		return 0, "FORMAT_ERROR"
+	}
+}
@@ -0,0 +1,113 @@
+package livr
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// currencyMinorUnits - ISO 4217 currency codes with number of digits after
+// decimal separator, -1 for codes without minor units defined (e.g. gold).
+var currencyMinorUnits = func() map[string]int {
+	groups := map[int]string{
+		0: "BIF CLP DJF GNF ISK JPY KMF KRW PYG RWF UGX UYI VND VUV XAF XOF XPF",
+		2: "AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BMD BND BOB BOV BRL BSD BTN BWP BYN BZD " +
+			"CAD CDF CHE CHF CHW CNY COP COU CRC CUC CUP CVE CZK DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL " +
+			"GHS GIP GMD GTQ GYD HKD HNL HTG HUF IDR ILS INR IRR JMD KES KGS KHR KPW KYD KZT LAK LBP LKR LRD " +
+			"LSL MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MXV MYR MZN NAD NGN NIO NOK NPR NZD PAB PEN " +
+			"PGK PHP PKR PLN QAR RON RSD RUB SAR SBD SCR SDG SEK SGD SHP SLE SLL SOS SRD SSP STN SVC SYP SZL " +
+			"THB TJS TMT TOP TRY TTD TWD TZS UAH USD USN UYU UZS VED VES WST XCD XCG YER ZAR ZMW ZWG ZWL",
+		3:  "BHD IQD JOD KWD LYD OMR TND",
+		4:  "CLF UYW",
+		-1: "XAG XAU XBA XBB XBC XBD XDR XPD XPT XSU XTS XUA XXX",
+	}
+
+	units := make(map[string]int)
+	for n, codes := range groups {
+		for _, code := range strings.Fields(codes) {
+			units[code] = n
+		}
+	}
+	return units
+}()
+
+var amountRe = regexp.MustCompile(`^(-?)(\d+)(?:\.(\d+))?$`)
+
+// currency - make sure that validated value is ISO 4217 currency code, output
+// is upper case.
+func currency(args ...interface{}) Validation {
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+		code := strings.ToUpper(v)
+		if _, ok := currencyMinorUnits[code]; !ok {
+			return nil, errors.New("NOT_CURRENCY")
+		}
+
+		return code, nil
+	}
+}
+
+// amountForCurrency - make sure that validated value is decimal amount with
+// no more digits after decimal separator than validated currency of other
+// field allows, e.g. {"amount_for_currency": "currency"}. Output is string
+// with exactly that number of digits, "10.5" for USD becomes "10.50".
+func amountForCurrency(args ...interface{}) Validation {
+	field, _ := firstArg(args...).(string)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if isEmpty(value) {
+			return value, nil
+		}
+
+		var amount string
+		switch v := value.(type) {
+		case string:
+			amount = v
+		default:
+			f, ok := numberValue(value)
+			if !ok {
+				return nil, errors.New("FORMAT_ERROR")
+			}
+			amount = strconv.FormatFloat(f, 'f', -1, 64)
+		}
+
+		m := amountRe.FindStringSubmatch(amount)
+		if m == nil {
+			return nil, errors.New("NOT_DECIMAL")
+		}
+		sign, whole, fraction := m[1], strings.TrimLeft(m[2], "0"), strings.TrimRight(m[3], "0")
+		if whole == "" {
+			whole = "0"
+		}
+
+		// Without valid currency the scale can't be checked.
+		code, _ := validatedField(field, builders...)
+		currency, _ := code.(string)
+		units, ok := currencyMinorUnits[strings.ToUpper(currency)]
+		if !ok {
+			return nil, errors.New("UNKNOWN_CURRENCY")
+		}
+		if units >= 0 {
+			if len(fraction) > units {
+				return nil, errors.New("TOO_MANY_DECIMALS")
+			}
+			fraction += strings.Repeat("0", units-len(fraction))
+		}
+
+		if whole == "0" && strings.Trim(fraction, "0") == "" {
+			sign = ""
+		}
+		if fraction != "" {
+			return sign + whole + "." + fraction, nil
+		}
+		return sign + whole, nil
+	}
+}
@@ -0,0 +1,215 @@
+package livr
+
+import (
+	"errors"
+	"log"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// clockRules - rules that depend on current time, they are rebuilt with clock
+// from Options.Now.
+var clockRules = map[string]func(func() time.Time) Builder{
+	"min_date":     minDate,
+	"max_date":     maxDate,
+	"date_between": dateBetween,
+}
+
+// dateOffsetRe - offset from current time, units are h(ours), d(ays),
+// w(eeks), m(onths) and y(ears), e.g. "+30d" or "-18y".
+var dateOffsetRe = regexp.MustCompile(`^([+-]\d+)([hdwmy])$`)
+
+// isoDatetime - make sure that validated value is date and time in RFC 3339
+// format with offset, e.g. "2006-01-02T15:04:05+07:00". "time" flag makes
+// output time.Time.
+func isoDatetime(args ...interface{}) Validation {
+	asTime := hasFlag("time", args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		switch v := value.(type) {
+		case time.Time:
+			return v, nil
+		case string:
+			t, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return nil, errors.New("WRONG_DATE")
+			}
+			if asTime {
+				return t, nil
+			}
+			return v, nil
+		default:
+			return nil, errors.New("FORMAT_ERROR")
+		}
+	}
+}
+
+// minDate - make sure that validated date is not earlier than bound, see
+// dateLimit.
+func minDate(now func() time.Time) Builder {
+	return func(args ...interface{}) Validation {
+		limits := dateLimits("min_date", 1, args...)
+		return dateRange(now, limits[0], nil, hasFlag("time", args...))
+	}
+}
+
+// maxDate - make sure that validated date is not later than bound, see
+// dateLimit.
+func maxDate(now func() time.Time) Builder {
+	return func(args ...interface{}) Validation {
+		limits := dateLimits("max_date", 1, args...)
+		return dateRange(now, nil, limits[0], hasFlag("time", args...))
+	}
+}
+
+// dateBetween - make sure that validated date is between bounds, see
+// dateLimit.
+func dateBetween(now func() time.Time) Builder {
+	return func(args ...interface{}) Validation {
+		limits := dateLimits("date_between", 2, args...)
+		return dateRange(now, limits[0], limits[1], hasFlag("time", args...))
+	}
+}
+
+// dateLimits - parse n bounds of date rule, broken bounds panic so that rule
+// accepting any date is never built.
+func dateLimits(rule string, n int, args ...interface{}) []*dateLimit {
+	var limits []*dateLimit
+	for _, arg := range args {
+		if _, ok := arg.(map[string]Builder); ok || arg == "time" {
+			continue
+		}
+		limit, ok := parseDateLimit(arg)
+		if !ok {
+			log.Panicf("%s: invalid date bound %v", rule, arg)
+		}
+		limits = append(limits, limit)
+	}
+	if len(limits) != n {
+		log.Panicf("%s: expected %d date bound(s), got %d", rule, n, len(limits))
+	}
+	return limits
+}
+
+// dateRange - check that date or date with time is within inclusive bounds.
+// Dates without time are compared by day. "time" flag makes output time.Time.
+func dateRange(now func() time.Time, min, max *dateLimit, asTime bool) Validation {
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		t, dateOnly, err := parseDateValue(value)
+		if err != nil {
+			return nil, err
+		}
+
+		current := now()
+		if min != nil && min.compare(t, dateOnly, current) < 0 {
+			return nil, errors.New("TOO_EARLY")
+		}
+		if max != nil && max.compare(t, dateOnly, current) > 0 {
+			return nil, errors.New("TOO_LATE")
+		}
+
+		if asTime {
+			return t, nil
+		}
+		return value, nil
+	}
+}
+
+// parseDateValue - parse date from time.Time, RFC 3339 or "2006-01-02" string.
+func parseDateValue(value interface{}) (time.Time, bool, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, false, nil
+	case string:
+		if t, err := time.Parse("2006-01-02", v); err == nil {
+			return t, true, nil
+		}
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return t, false, nil
+		}
+		return time.Time{}, false, errors.New("WRONG_DATE")
+	default:
+		return time.Time{}, false, errors.New("FORMAT_ERROR")
+	}
+}
+
+// dateLimit - bound of date rules: date, date with time, "current" time or
+// offset from current time like "+30d".
+type dateLimit struct {
+	date     time.Time
+	dateOnly bool
+
+	relative            bool
+	years, months, days int
+	hours               time.Duration
+}
+
+func parseDateLimit(arg interface{}) (*dateLimit, bool) {
+	s, ok := arg.(string)
+	if !ok {
+		return nil, false
+	}
+	if s == "current" {
+		return &dateLimit{relative: true}, true
+	}
+
+	if m := dateOffsetRe.FindStringSubmatch(s); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return nil, false
+		}
+		l := &dateLimit{relative: true}
+		switch m[2] {
+		case "h":
+			l.hours = time.Duration(n) * time.Hour
+		case "d":
+			l.days = n
+		case "w":
+			l.days = 7 * n
+		case "m":
+			l.months = n
+		case "y":
+			l.years = n
+		}
+		return l, true
+	}
+
+	t, dateOnly, err := parseDateValue(s)
+	if err != nil {
+		return nil, false
+	}
+	return &dateLimit{date: t, dateOnly: dateOnly}, true
+}
+
+// compare - compare t with limit, by day when any of them has no time.
+func (l *dateLimit) compare(t time.Time, dateOnly bool, now time.Time) int {
+	limit := l.date
+	if l.relative {
+		limit = now.AddDate(l.years, l.months, l.days).Add(l.hours)
+	}
+	if dateOnly || l.dateOnly {
+		t, limit = day(t), day(limit)
+	}
+
+	switch {
+	case t.Before(limit):
+		return -1
+	case t.After(limit):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
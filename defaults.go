@@ -1,8 +1,42 @@
 package livr
 
+import "strings"
+
 func firstArg(args ...interface{}) interface{} {
 	if len(args) > 0 {
 		return args[0]
 	}
 	return nil
 }
+
+// hasFlag - check that rule args contain string flag, e.g. {"ipv6": "canonical"}.
+func hasFlag(flag string, args ...interface{}) bool {
+	for _, arg := range args {
+		if v, ok := arg.(string); ok && v == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupField - return value of field from data, path can be dotted to get
+// value from nested objects, e.g. "address.country".
+func lookupField(data Dictionary, path string) (interface{}, bool) {
+	var value interface{} = data
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := value.(Dictionary)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = obj[key]; !ok {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// isEmpty - check that value is absent in terms of LIVR.
+func isEmpty(value interface{}) bool {
+	return value == nil || value == ""
+}
@@ -0,0 +1,155 @@
+package livr
+
+import (
+	"errors"
+	"log"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// durationOptions - names of "duration" options.
+var durationOptions = map[string]bool{"min": true, "max": true, "format": true}
+
+// durationFormats - output formats of "duration".
+var durationFormats = map[string]bool{"go": true, "iso": true, "seconds": true}
+
+// isoDurationRe - ISO 8601 duration with weeks, days, hours, minutes and
+// seconds, years and months have no fixed length and are not supported.
+var isoDurationRe = regexp.MustCompile(`^P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+// duration - make sure that validated value is Go ("1h30m") or ISO 8601
+// ("PT1H30M") duration, e.g. {"duration": {"min": "1s", "max": "24h", "format": "iso"}}.
+// "format" option converts output to "go", "iso" string or number of "seconds".
+func duration(args ...interface{}) Validation {
+	var opts Dictionary
+	switch v := firstArg(args...).(type) {
+	case Dictionary:
+		if len(v) > 0 && !isOptions(v, durationOptions) {
+			log.Panicf("duration: unknown options in %v", v)
+		}
+		opts = v
+	case nil, map[string]Builder:
+	default:
+		log.Panicf("duration: invalid options %v", v)
+	}
+
+	min, hasMin, err := durationOption(opts, "min")
+	if err != nil {
+		log.Panicf("duration: %v", err)
+	}
+	max, hasMax, err := durationOption(opts, "max")
+	if err != nil {
+		log.Panicf("duration: %v", err)
+	}
+	format, _ := opts["format"].(string)
+	if _, ok := opts["format"]; ok && !durationFormats[format] {
+		log.Panicf("duration: unknown format %v", opts["format"])
+	}
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+		d, ok := parseDuration(v)
+		if !ok {
+			return nil, errors.New("NOT_DURATION")
+		}
+
+		if hasMin && d < min {
+			return nil, errors.New("TOO_SHORT")
+		}
+		if hasMax && d > max {
+			return nil, errors.New("TOO_LONG")
+		}
+
+		switch format {
+		case "go":
+			return d.String(), nil
+		case "iso":
+			return isoDuration(d), nil
+		case "seconds":
+			return d.Seconds(), nil
+		default:
+			return v, nil
+		}
+	}
+}
+
+func durationOption(opts Dictionary, name string) (time.Duration, bool, error) {
+	raw, ok := opts[name]
+	if !ok {
+		return 0, false, nil
+	}
+	if s, ok := raw.(string); ok {
+		if d, ok := parseDuration(s); ok {
+			return d, true, nil
+		}
+	}
+	return 0, false, errors.New("invalid " + name + " duration")
+}
+
+// parseDuration - parse Go or ISO 8601 duration.
+func parseDuration(value string) (time.Duration, bool) {
+	if !strings.HasPrefix(value, "P") {
+		d, err := time.ParseDuration(value)
+		return d, err == nil
+	}
+
+	m := isoDurationRe.FindStringSubmatch(value)
+	if m == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, false
+	}
+
+	var d float64
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	for i, unit := range units {
+		if m[i+1] == "" {
+			continue
+		}
+		n, err := strconv.ParseFloat(strings.Replace(m[i+1], ",", ".", 1), 64)
+		if err != nil {
+			return 0, false
+		}
+		d += n * float64(unit)
+	}
+	if d > math.MaxInt64 {
+		return 0, false
+	}
+
+	return time.Duration(d), true
+}
+
+// isoDuration - format duration in ISO 8601 with hours, minutes and seconds.
+func isoDuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	if d < 0 {
+		b.WriteString("-")
+		d = -d
+	}
+	b.WriteString("PT")
+	if h := d / time.Hour; h > 0 {
+		b.WriteString(strconv.FormatInt(int64(h), 10) + "H")
+		d -= h * time.Hour
+	}
+	if m := d / time.Minute; m > 0 {
+		b.WriteString(strconv.FormatInt(int64(m), 10) + "M")
+		d -= m * time.Minute
+	}
+	if d > 0 {
+		b.WriteString(strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "S")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,183 @@
+package livr
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// cardBrand - payment card brand detected by number prefix.
+type cardBrand struct {
+	name     string
+	prefixes [][2]int // inclusive ranges of prefixes of the same length.
+}
+
+// cardBrands - brands in detection order, more specific prefixes first.
+var cardBrands = []cardBrand{
+	{"amex", [][2]int{{34, 34}, {37, 37}}},
+	{"diners", [][2]int{{300, 305}, {36, 36}, {38, 39}}},
+	{"jcb", [][2]int{{3528, 3589}}},
+	{"visa", [][2]int{{4, 4}}},
+	{"mastercard", [][2]int{{51, 55}, {2221, 2720}}},
+	{"discover", [][2]int{{6011, 6011}, {622126, 622925}, {644, 649}, {65, 65}}},
+	{"unionpay", [][2]int{{62, 62}}},
+	{"maestro", [][2]int{{50, 50}, {56, 58}, {6, 6}}},
+}
+
+func cardBrandNames() []string {
+	names := make([]string, 0, len(cardBrands))
+	for _, brand := range cardBrands {
+		names = append(names, brand.name)
+	}
+	return names
+}
+
+var cardSeparatorsRe = regexp.MustCompile(`[ -]`)
+
+// creditCard - make sure that validated value is payment card number with
+// valid Luhn checksum, spaces and dashes are removed from output. Arguments
+// restrict brands, e.g. {"credit_card": ["visa", "mastercard"]}.
+func creditCard(args ...interface{}) Validation {
+	brands := make(map[string]bool)
+	for _, arg := range args {
+		if s, ok := arg.(string); ok {
+			brands[s] = true
+		}
+	}
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+		number := cardSeparatorsRe.ReplaceAllString(v, "")
+		if len(number) < 12 || len(number) > 19 || strings.Trim(number, "0123456789") != "" {
+			return nil, errors.New("NOT_CREDIT_CARD")
+		}
+		if !luhn(number) {
+			return nil, errors.New("WRONG_CHECKSUM")
+		}
+		if len(brands) > 0 && !brands[detectCardBrand(number)] {
+			return nil, errors.New("NOT_ALLOWED_BRAND")
+		}
+
+		return number, nil
+	}
+}
+
+func luhn(number string) bool {
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		d := int(number[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func detectCardBrand(number string) string {
+	for _, brand := range cardBrands {
+		for _, r := range brand.prefixes {
+			n := len(strconv.Itoa(r[0]))
+			if n > len(number) {
+				continue
+			}
+			prefix := 0
+			for _, c := range number[:n] {
+				prefix = prefix*10 + int(c-'0')
+			}
+			if prefix >= r[0] && prefix <= r[1] {
+				return brand.name
+			}
+		}
+	}
+	return ""
+}
+
+// ibanLengths - IBAN length by country code.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33,
+	"SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+var ibanRe = regexp.MustCompile(`^[A-Z]{2}\d{2}[A-Z0-9]+$`)
+
+// iban - make sure that validated value is IBAN of known country with valid
+// length and checksum, output is upper case without spaces.
+func iban(args ...interface{}) Validation {
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+		number := strings.ToUpper(strings.Replace(v, " ", "", -1))
+		if !ibanRe.MatchString(number) || ibanLengths[number[:2]] != len(number) {
+			return nil, errors.New("NOT_IBAN")
+		}
+		if ibanChecksum(number) != 1 {
+			return nil, errors.New("WRONG_CHECKSUM")
+		}
+
+		return number, nil
+	}
+}
+
+// ibanChecksum - ISO 7064 mod 97-10 of IBAN with country and check digits
+// moved to the end and letters replaced with numbers.
+func ibanChecksum(number string) int {
+	mod := 0
+	for _, c := range number[4:] + number[:4] {
+		if c >= 'A' && c <= 'Z' {
+			mod = (mod*100 + int(c-'A'+10)) % 97
+		} else {
+			mod = (mod*10 + int(c-'0')) % 97
+		}
+	}
+	return mod
+}
+
+var bicRe = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}(?:[A-Z0-9]{3})?$`)
+
+// bic - make sure that validated value is BIC (SWIFT code) of 8 or 11
+// characters, output is upper case.
+func bic(args ...interface{}) Validation {
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+		code := strings.ToUpper(v)
+		if !bicRe.MatchString(code) {
+			return nil, errors.New("NOT_BIC")
+		}
+
+		return code, nil
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/k33nice/go-livr
+
+go 1.18
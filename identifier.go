@@ -0,0 +1,174 @@
+package livr
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var uuidRe = regexp.MustCompile(`^(?i)[0-9a-f]{8}-[0-9a-f]{4}-([0-9a-f])[0-9a-f]{3}-([0-9a-f])[0-9a-f]{3}-[0-9a-f]{12}$`)
+
+// uuid - make sure that validated value is valid UUID. Version can be
+// restricted with argument, e.g. {"uuid": 4} or {"uuid": "v4"}.
+func uuid(args ...interface{}) Validation {
+	version := uuidVersion(firstArg(args...))
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		m := uuidRe.FindStringSubmatch(v)
+		if m == nil {
+			return nil, errors.New("NOT_UUID")
+		}
+		if version != "" {
+			// Versioned UUIDs use RFC 4122 variant, so the variant digit is one of 8, 9, a, b.
+			if m[1] != version || !strings.ContainsAny(m[2], "89abAB") {
+				return nil, errors.New("NOT_UUID")
+			}
+		}
+
+		return value, nil
+	}
+}
+
+// uuidVersion - return UUID version digit from rule argument or empty string
+// when version is not restricted.
+func uuidVersion(arg interface{}) string {
+	switch v := arg.(type) {
+	case float64:
+		return fmt.Sprint(v)
+	case string:
+		return strings.TrimPrefix(v, "v")
+	default:
+		return ""
+	}
+}
+
+var ulidRe = regexp.MustCompile(`^(?i)[0-7][0-9a-hjkmnp-tv-z]{25}$`)
+
+// ulid - make sure that validated value is valid ULID.
+func ulid(args ...interface{}) Validation {
+	return formatRule(ulidRe, "NOT_ULID")
+}
+
+var mongoIDRe = regexp.MustCompile(`^(?i)[0-9a-f]{24}$`)
+
+// mongoID - make sure that validated value is valid MongoDB ObjectId.
+func mongoID(args ...interface{}) Validation {
+	return formatRule(mongoIDRe, "NOT_ID")
+}
+
+var (
+	md5Re    = regexp.MustCompile(`^(?i)[0-9a-f]{32}$`)
+	sha1Re   = regexp.MustCompile(`^(?i)[0-9a-f]{40}$`)
+	sha256Re = regexp.MustCompile(`^(?i)[0-9a-f]{64}$`)
+	sha512Re = regexp.MustCompile(`^(?i)[0-9a-f]{128}$`)
+)
+
+// md5 - make sure that validated value is hex encoded MD5 digest.
+func md5(args ...interface{}) Validation {
+	return formatRule(md5Re, "NOT_MD5")
+}
+
+// sha1 - make sure that validated value is hex encoded SHA-1 digest.
+func sha1(args ...interface{}) Validation {
+	return formatRule(sha1Re, "NOT_SHA1")
+}
+
+// sha256 - make sure that validated value is hex encoded SHA-256 digest.
+func sha256(args ...interface{}) Validation {
+	return formatRule(sha256Re, "NOT_SHA256")
+}
+
+// sha512 - make sure that validated value is hex encoded SHA-512 digest.
+func sha512(args ...interface{}) Validation {
+	return formatRule(sha512Re, "NOT_SHA512")
+}
+
+// formatRule - build validation that checks string value against regexp.
+func formatRule(re *regexp.Regexp, code string) Validation {
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+		if !re.MatchString(v) {
+			return nil, errors.New(code)
+		}
+
+		return value, nil
+	}
+}
+
+// _base64 - make sure that validated value is base64 encoded string.
+// Optional numeric arguments limit decoded size: one is max size, two are
+// min and max size. With "relaxed" flag padding can be omitted.
+func _base64(args ...interface{}) Validation {
+	return base64Rule(base64.StdEncoding, base64.RawStdEncoding, args...)
+}
+
+// base64URL - make sure that validated value is base64url encoded string.
+// Takes the same arguments as base64, padding is always optional.
+func base64URL(args ...interface{}) Validation {
+	return base64Rule(base64.URLEncoding, base64.RawURLEncoding, append(args, "relaxed")...)
+}
+
+func base64Rule(padded, raw *base64.Encoding, args ...interface{}) Validation {
+	relaxed := hasFlag("relaxed", args...)
+
+	var sizes []float64
+	for _, arg := range args {
+		if v, ok := arg.(float64); ok {
+			sizes = append(sizes, v)
+		}
+	}
+	minSize, maxSize := -1.0, -1.0
+	switch len(sizes) {
+	case 1:
+		maxSize = sizes[0]
+	case 2:
+		minSize, maxSize = sizes[0], sizes[1]
+	}
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		enc := padded
+		if relaxed && !strings.HasSuffix(v, "=") {
+			enc = raw
+		}
+		data, err := enc.Strict().DecodeString(v)
+		if err != nil {
+			return nil, errors.New("MALFORMED_BASE64")
+		}
+
+		if maxSize >= 0 && float64(len(data)) > maxSize {
+			return nil, errors.New("TOO_LONG")
+		}
+		if float64(len(data)) < minSize {
+			return nil, errors.New("TOO_SHORT")
+		}
+
+		return value, nil
+	}
+}
@@ -0,0 +1,818 @@
+package livr
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// LintIssue - problem found in rules by linter.
+type LintIssue struct {
+	// Path - JSON path to the broken rule, e.g. "$.password[1].min_length".
+	Path    string `json:"path"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (i LintIssue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Path, i.Code, i.Message)
+}
+
+// Lint - check rules against default rules and report all found problems.
+func Lint(rules Dictionary) []LintIssue {
+	return New(&Options{LivrRules: rules}).Lint()
+}
+
+// Lint - check validator rules against registered rules and report all found
+// problems instead of panic on the first one.
+func (v *Validator) Lint() []LintIssue {
+	l := &linter{builders: v.validatorBuilders}
+	l.lintObject("$", v.livrRules)
+
+	return l.issues
+}
+
+type lintCheck = func(l *linter, path string, args []interface{}, scope Dictionary)
+
+var lintChecks map[string]lintCheck
+
+func init() {
+	lintChecks = map[string]lintCheck{
+		"nullable": lintFlags(),
+
+		// Boolean rules.
+		"boolean": lintBoolean,
+		"is":      lintIs,
+
+		// Conditional requirement rules.
+		"required_if":      lintCondition,
+		"required_unless":  lintCondition,
+		"required_with":    lintFieldRefs,
+		"required_without": lintFieldRefs,
+
+		// List rules.
+		"list_length":       lintListLength,
+		"list_items_unique": lintFlags(),
+		"unique_by":         lintFieldNames,
+		"list_contains":     lintOneOf,
+		"subset_of":         lintOneOf,
+
+		// Object rules.
+		"at_least_one_of":    lintFieldRefs,
+		"exactly_one_of":     lintFieldRefs,
+		"mutually_exclusive": lintFieldRefs,
+		"all_or_none":        lintFieldRefs,
+
+		// Text related rules.
+		"one_of":         lintOneOf,
+		"min_length":     lintNumberArgs(1),
+		"max_length":     lintNumberArgs(1),
+		"length_equal":   lintNumberArgs(1),
+		"length_between": lintRange,
+		"like":           lintLike,
+
+		// Rules for real numbers.
+		"min_number":     lintNumberArgs(1),
+		"max_number":     lintNumberArgs(1),
+		"number_between": lintRange,
+
+		// Misc rules.
+		"equal_to_field": lintFieldRef,
+		"iso_date":       lintFlags("time"),
+
+		// Date rules.
+		"iso_datetime": lintFlags("time"),
+		"min_date":     lintDateLimits(1),
+		"max_date":     lintDateLimits(1),
+		"date_between": lintDateLimits(2),
+
+		// Timestamp modifiers.
+		"normalize_timestamp": lintTimestamp,
+
+		// Cross-field comparison rules.
+		"greater_than_field": lintInclusiveFieldRef,
+		"less_than_field":    lintInclusiveFieldRef,
+		"not_equal_to_field": lintFieldRef,
+		"after_field":        lintInclusiveFieldRef,
+		"before_field":       lintInclusiveFieldRef,
+
+		// Configuration format rules.
+		"duration": lintDuration,
+		"cron":     lintFlags(),
+		"semver":   lintSemver,
+
+		// Finance rules.
+		"credit_card": lintFlags(cardBrandNames()...),
+		"iban":        lintFlags(),
+		"bic":         lintFlags(),
+
+		// Currency rules.
+		"currency":            lintFlags(),
+		"amount_for_currency": lintFieldRef,
+
+		// Locale rules.
+		"country":      lintFlags("alpha2", "alpha3", "canonical"),
+		"language":     lintFlags("alpha2", "alpha3", "canonical"),
+		"language_tag": lintFlags("canonical"),
+		"time_zone":    lintFlags("canonical"),
+
+		// Phone rules.
+		"phone": lintPhone,
+
+		// Network rules.
+		"ipv4":        lintFlags("canonical"),
+		"ipv6":        lintFlags("canonical"),
+		"ip":          lintFlags("canonical"),
+		"cidr":        lintFlags("ipv4", "ipv6", "canonical"),
+		"mac_address": lintFlags("canonical"),
+		"hostname":    lintFlags("canonical"),
+		"port":        lintFlags(),
+
+		// Identifier rules.
+		"uuid":      lintUUID,
+		"ulid":      lintFlags(),
+		"mongo_id":  lintFlags(),
+		"md5":       lintFlags(),
+		"sha1":      lintFlags(),
+		"sha256":    lintFlags(),
+		"sha512":    lintFlags(),
+		"base64":    lintBase64,
+		"base64url": lintBase64,
+
+		// Meta rules.
+		"nested_object":             lintNestedObject,
+		"list_of_objects":           lintNestedObject,
+		"list_of":                   lintListOf,
+		"or":                        lintOr,
+		"and":                       lintAnd,
+		"not":                       lintNot,
+		"if":                        lintIf,
+		"map_of":                    lintMapOf,
+		"tuple":                     lintTuple,
+		"variable_object":           lintVariableObject,
+		"list_of_different_objects": lintVariableObject,
+	}
+}
+
+type linter struct {
+	builders map[string]Builder
+	issues   []LintIssue
+}
+
+func (l *linter) report(path, code, format string, args ...interface{}) {
+	l.issues = append(l.issues, LintIssue{Path: path, Code: code, Message: fmt.Sprintf(format, args...)})
+}
+
+func (l *linter) lintObject(path string, rules Dictionary) {
+	fields := make([]string, 0, len(rules))
+	for field := range rules {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		l.lintChain(path+"."+field, rules[field], rules)
+	}
+}
+
+// lintChain - check list of rules applied to the same value, scope is the
+// object rules the value belongs to (nil when there are no sibling fields).
+func (l *linter) lintChain(path string, chain interface{}, scope Dictionary) {
+	rules, ok := chain.([]interface{})
+	if !ok {
+		rules = []interface{}{chain}
+	}
+
+	bounds := make(map[string]float64)
+	for i, rawRule := range rules {
+		rulePath := path
+		if ok {
+			rulePath = fmt.Sprintf("%s[%d]", path, i)
+		}
+
+		name, args := l.lintRule(rulePath, rawRule, scope)
+		switch name {
+		case "min_length", "max_length", "min_number", "max_number":
+			if n, ok := numberArg(firstArg(args...)); ok {
+				bounds[name] = n
+			}
+		}
+	}
+
+	l.lintBounds(path, bounds, "min_length", "max_length")
+	l.lintBounds(path, bounds, "min_number", "max_number")
+}
+
+func (l *linter) lintBounds(path string, bounds map[string]float64, min, max string) {
+	minVal, hasMin := bounds[min]
+	maxVal, hasMax := bounds[max]
+	if hasMin && hasMax && minVal > maxVal {
+		l.report(path, "CONFLICTING_RULES", "%s %v is greater than %s %v", min, minVal, max, maxVal)
+	}
+}
+
+func (l *linter) lintRule(path string, rawRule interface{}, scope Dictionary) (string, []interface{}) {
+	switch rule := rawRule.(type) {
+	case string:
+	case map[string]interface{}:
+		if len(rule) != 1 {
+			l.report(path, "INVALID_RULE", "rule object must have exactly one key, got %d", len(rule))
+			return "", nil
+		}
+	default:
+		l.report(path, "INVALID_RULE", "rule must be a string or an object, got %T", rawRule)
+		return "", nil
+	}
+
+	name, args := parseRule(rawRule)
+	path = strings.TrimSuffix(path+"."+name, ".")
+
+	if _, ok := l.builders[name]; !ok {
+		if s := suggestRule(name, l.builders); s != "" {
+			l.report(path, "UNKNOWN_RULE", "rule %q is not registered, did you mean %q?", name, s)
+		} else {
+			l.report(path, "UNKNOWN_RULE", "rule %q is not registered", name)
+		}
+		return name, args
+	}
+
+	if _, ok := typedRules[name]; ok {
+		_, args = typeFlag(args)
+	}
+
+	issues := len(l.issues)
+	if check, ok := lintChecks[name]; ok {
+		check(l, path, args, scope)
+	}
+	if len(l.issues) == issues {
+		l.lintBuild(path, name, args)
+	}
+
+	return name, args
+}
+
+// lintBuild - build rule to catch problems that static checks are not aware of.
+func (l *linter) lintBuild(path, name string, args []interface{}) {
+	defer func() {
+		if r := recover(); r != nil {
+			l.report(path, "BROKEN_RULE", "%v", r)
+		}
+	}()
+
+	l.builders[name](append(append([]interface{}{}, args...), l.builders)...)
+}
+
+func lintNumberArgs(n int) lintCheck {
+	return func(l *linter, path string, args []interface{}, scope Dictionary) {
+		if len(args) != n {
+			l.report(path, "INVALID_ARGS", "expected %d numeric argument(s), got %d", n, len(args))
+			return
+		}
+		for _, arg := range args {
+			if _, ok := numberArg(arg); !ok {
+				l.report(path, "INVALID_ARGS", "expected numeric argument, got %v", arg)
+				return
+			}
+		}
+	}
+}
+
+func lintFlags(flags ...string) lintCheck {
+	return func(l *linter, path string, args []interface{}, scope Dictionary) {
+		for _, arg := range args {
+			known := false
+			for _, flag := range flags {
+				if arg == flag {
+					known = true
+				}
+			}
+			if !known {
+				l.report(path, "INVALID_ARGS", "unknown argument %v, expected one of %v", arg, flags)
+			}
+		}
+	}
+}
+
+func lintUUID(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) > 1 {
+		l.report(path, "INVALID_ARGS", "expected at most one argument, got %d", len(args))
+		return
+	}
+	if len(args) == 0 {
+		return
+	}
+
+	version := uuidVersion(args[0])
+	if len(version) != 1 || !strings.Contains("12345678", version) {
+		l.report(path, "INVALID_ARGS", "unknown UUID version %v", args[0])
+	}
+}
+
+func lintBase64(l *linter, path string, args []interface{}, scope Dictionary) {
+	var sizes []interface{}
+	for _, arg := range args {
+		if arg != "relaxed" {
+			sizes = append(sizes, arg)
+		}
+	}
+
+	switch len(sizes) {
+	case 0:
+	case 1:
+		lintNumberArgs(1)(l, path, sizes, scope)
+	default:
+		lintRange(l, path, sizes, scope)
+	}
+}
+
+func lintRange(l *linter, path string, args []interface{}, scope Dictionary) {
+	issues := len(l.issues)
+	lintNumberArgs(2)(l, path, args, scope)
+	if len(l.issues) != issues {
+		return
+	}
+
+	min, _ := numberArg(args[0])
+	max, _ := numberArg(args[1])
+	if min > max {
+		l.report(path, "INVALID_ARGS", "lower bound %v is greater than upper bound %v", min, max)
+	}
+}
+
+func lintDateLimits(n int) lintCheck {
+	return func(l *linter, path string, args []interface{}, scope Dictionary) {
+		var limits []*dateLimit
+		for _, arg := range args {
+			if arg == "time" {
+				continue
+			}
+			limit, ok := parseDateLimit(arg)
+			if !ok {
+				l.report(path, "INVALID_ARGS", "expected date, \"current\" or offset like \"+30d\", got %v", arg)
+				return
+			}
+			limits = append(limits, limit)
+		}
+		if len(limits) != n {
+			l.report(path, "INVALID_ARGS", "expected %d date argument(s), got %d", n, len(limits))
+			return
+		}
+
+		if n == 2 && !limits[0].relative && !limits[1].relative && limits[0].date.After(limits[1].date) {
+			l.report(path, "INVALID_ARGS", "lower bound %v is after upper bound %v", args[0], args[1])
+		}
+	}
+}
+
+func lintTimestamp(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) == 0 {
+		return
+	}
+
+	opts := Dictionary{}
+	switch v := args[0].(type) {
+	case string:
+		opts["zone"] = v
+	case Dictionary:
+		if !isOptions(v, timestampOptions) {
+			l.report(path, "INVALID_ARGS", "unknown options %v, expected layouts, zone, input_zone and format", v)
+			return
+		}
+		opts = v
+	default:
+		l.report(path, "INVALID_ARGS", "expected time zone or options object, got %v", args[0])
+		return
+	}
+	if len(args) > 1 {
+		l.report(path, "INVALID_ARGS", "expected single argument, got %d", len(args))
+	}
+
+	if _, _, err := timestampZones(opts); err != nil {
+		l.report(path, "INVALID_ARGS", "%v", err)
+	}
+	if layouts, ok := opts["layouts"]; ok {
+		list, ok := layouts.([]interface{})
+		if !ok || len(list) == 0 {
+			l.report(path, "INVALID_ARGS", "expected non-empty list of layouts, got %v", layouts)
+		}
+		for _, layout := range list {
+			if _, ok := layout.(string); !ok {
+				l.report(path, "INVALID_ARGS", "expected layout string, got %v", layout)
+			}
+		}
+	}
+	if format, ok := opts["format"]; ok {
+		if _, ok := format.(string); !ok {
+			l.report(path, "INVALID_ARGS", "expected format string, got %v", format)
+		}
+	}
+}
+
+func lintDuration(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) == 0 {
+		return
+	}
+	opts, ok := args[0].(Dictionary)
+	if !ok || len(args) > 1 || !isOptions(opts, durationOptions) {
+		l.report(path, "INVALID_ARGS", "expected object with min, max and format options, got %v", args)
+		return
+	}
+
+	min, hasMin, err := durationOption(opts, "min")
+	if err != nil {
+		l.report(path, "INVALID_ARGS", "%v: %v", err, opts["min"])
+	}
+	max, hasMax, err := durationOption(opts, "max")
+	if err != nil {
+		l.report(path, "INVALID_ARGS", "%v: %v", err, opts["max"])
+	}
+	if hasMin && hasMax && min > max {
+		l.report(path, "INVALID_ARGS", "min %v is greater than max %v", opts["min"], opts["max"])
+	}
+	if format, ok := opts["format"]; ok {
+		if s, _ := format.(string); !durationFormats[s] {
+			l.report(path, "INVALID_ARGS", "unknown format %v, expected one of [go iso seconds]", format)
+		}
+	}
+}
+
+func lintSemver(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) == 0 {
+		return
+	}
+	constraint, ok := args[0].(string)
+	if !ok || len(args) > 1 {
+		l.report(path, "INVALID_ARGS", "expected version range string, got %v", args)
+		return
+	}
+	if _, err := parseSemverRange(constraint); err != nil {
+		l.report(path, "INVALID_ARGS", "%v", err)
+	}
+}
+
+func lintPhone(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) == 0 {
+		return
+	}
+
+	opts := Dictionary{}
+	switch v := args[0].(type) {
+	case string:
+		opts["region"] = v
+	case Dictionary:
+		if !isOptions(v, phoneOptions) {
+			l.report(path, "INVALID_ARGS", "unknown options %v, expected region and regions", v)
+			return
+		}
+		opts = v
+	default:
+		l.report(path, "INVALID_ARGS", "expected region or options object, got %v", args[0])
+		return
+	}
+
+	if region, ok := opts["region"]; ok {
+		if s, _ := region.(string); phoneRegions[strings.ToUpper(s)].code == "" {
+			l.report(path, "INVALID_ARGS", "unknown region %v", region)
+		}
+	}
+	if regions, ok := opts["regions"]; ok {
+		list, ok := regions.([]interface{})
+		if !ok || len(list) == 0 {
+			l.report(path, "INVALID_ARGS", "expected non-empty list of regions, got %v", regions)
+		}
+		for _, region := range list {
+			if s, _ := region.(string); phoneRegions[strings.ToUpper(s)].code == "" {
+				l.report(path, "INVALID_ARGS", "unknown region %v", region)
+			}
+		}
+	}
+}
+
+func lintOneOf(l *linter, path string, args []interface{}, scope Dictionary) {
+	allowed := args
+	if v, ok := firstArg(args...).([]interface{}); ok {
+		allowed = v
+	}
+	if len(allowed) == 0 {
+		l.report(path, "INVALID_ARGS", "list of allowed values is empty")
+	}
+}
+
+func lintBoolean(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) == 0 {
+		return
+	}
+	opts, ok := args[0].(Dictionary)
+	if !ok || len(args) > 1 || !isOptions(opts, booleanOptions) {
+		l.report(path, "INVALID_ARGS", "expected object with truthy and falsy lists, got %v", args)
+		return
+	}
+	for key, values := range opts {
+		if _, ok := values.([]interface{}); !ok {
+			l.report(path, "INVALID_ARGS", "expected list of %s values, got %v", key, values)
+		}
+	}
+}
+
+func lintIs(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) != 1 {
+		l.report(path, "INVALID_ARGS", "expected exactly one value, got %d", len(args))
+		return
+	}
+	switch args[0].(type) {
+	case string, bool, float64:
+	default:
+		l.report(path, "INVALID_ARGS", "expected string, number or boolean, got %v", args[0])
+	}
+}
+
+func lintLike(l *linter, path string, args []interface{}, scope Dictionary) {
+	re, ok := firstArg(args...).(string)
+	if !ok {
+		l.report(path, "INVALID_ARGS", "expected regexp string, got %v", firstArg(args...))
+		return
+	}
+	if _, err := regexp.Compile(re); err != nil {
+		l.report(path, "INVALID_ARGS", "invalid regexp: %v", err)
+	}
+}
+
+func lintFieldRef(l *linter, path string, args []interface{}, scope Dictionary) {
+	field, ok := firstArg(args...).(string)
+	if !ok {
+		l.report(path, "INVALID_ARGS", "expected field name, got %v", firstArg(args...))
+		return
+	}
+	// Dotted path refers to nested object, only its root is known here.
+	if _, ok := scope[strings.Split(field, ".")[0]]; !ok {
+		l.report(path, "UNKNOWN_FIELD", "field %q has no rules in this object", field)
+	}
+}
+
+func lintInclusiveFieldRef(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) == 0 {
+		l.report(path, "INVALID_ARGS", "expected field name")
+		return
+	}
+	lintFieldRef(l, path, args[:1], scope)
+	lintFlags("inclusive")(l, path, args[1:], scope)
+}
+
+func lintCondition(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) < 2 {
+		l.report(path, "INVALID_ARGS", "expected field name and at least one value")
+		return
+	}
+	lintFieldRef(l, path, args[:1], scope)
+}
+
+func lintFieldRefs(l *linter, path string, args []interface{}, scope Dictionary) {
+	fields := args
+	if v, ok := firstArg(args...).([]interface{}); ok && len(args) == 1 {
+		fields = v
+	}
+	if len(fields) == 0 {
+		l.report(path, "INVALID_ARGS", "expected at least one field name")
+		return
+	}
+	for _, field := range fields {
+		lintFieldRef(l, path, []interface{}{field}, scope)
+	}
+}
+
+func lintListLength(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) == 1 {
+		lintNumberArgs(1)(l, path, args, scope)
+		return
+	}
+	lintRange(l, path, args, scope)
+}
+
+// lintFieldNames - check field names of list items, they are not known here.
+func lintFieldNames(l *linter, path string, args []interface{}, scope Dictionary) {
+	fields := args
+	if v, ok := firstArg(args...).([]interface{}); ok && len(args) == 1 {
+		fields = v
+	}
+	if len(fields) == 0 {
+		l.report(path, "INVALID_ARGS", "expected at least one field name")
+	}
+	for _, field := range fields {
+		if _, ok := field.(string); !ok {
+			l.report(path, "INVALID_ARGS", "expected field name, got %v", field)
+		}
+	}
+}
+
+func lintNestedObject(l *linter, path string, args []interface{}, scope Dictionary) {
+	rules, ok := firstArg(args...).(Dictionary)
+	if !ok || len(args) != 1 {
+		l.report(path, "INVALID_ARGS", "expected object with rules")
+		return
+	}
+	l.lintObject(path, rules)
+}
+
+func lintListOf(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) == 0 {
+		l.report(path, "INVALID_ARGS", "expected rules for list items")
+		return
+	}
+	if chain, ok := args[0].([]interface{}); ok {
+		l.lintChain(path, chain, nil)
+		return
+	}
+	l.lintChain(path, args, nil)
+}
+
+func lintOr(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) == 0 {
+		l.report(path, "INVALID_ARGS", "expected at least one rules alternative")
+		return
+	}
+	for i, chain := range args {
+		if opts, ok := chain.(Dictionary); ok && i == len(args)-1 && isOptions(opts, orOptions) {
+			if mode := opts["errors"]; mode != "last" && mode != "all" && mode != "best" {
+				l.report(path, "INVALID_ARGS", "unknown errors mode %v, expected one of [last all best]", mode)
+			}
+			continue
+		}
+		l.lintChain(fmt.Sprintf("%s[%d]", path, i), chain, nil)
+	}
+}
+
+func lintAnd(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) == 0 {
+		l.report(path, "INVALID_ARGS", "expected at least one rules chain")
+		return
+	}
+	for i, chain := range args {
+		l.lintChain(fmt.Sprintf("%s[%d]", path, i), chain, nil)
+	}
+}
+
+func lintNot(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) > 1 {
+		if opts, ok := args[len(args)-1].(Dictionary); ok && isOptions(opts, notOptions) {
+			if _, ok := opts["error"].(string); !ok {
+				l.report(path, "INVALID_ARGS", "expected error code string, got %v", opts["error"])
+			}
+			args = args[:len(args)-1]
+		}
+	}
+
+	switch len(args) {
+	case 0:
+		l.report(path, "INVALID_ARGS", "expected rules chain")
+	case 1:
+		if chain, ok := args[0].([]interface{}); ok && len(chain) == 0 {
+			l.report(path, "INVALID_ARGS", "expected rules chain")
+			return
+		}
+		l.lintChain(path, args[0], nil)
+	default:
+		l.lintChain(path, args, nil)
+	}
+}
+
+func lintIf(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) < 2 || len(args) > 3 {
+		l.report(path, "INVALID_ARGS", "expected condition, then and optional else rules chains")
+		return
+	}
+	for i, chain := range args {
+		l.lintChain(fmt.Sprintf("%s[%d]", path, i), chain, nil)
+	}
+}
+
+func lintMapOf(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) < 2 || len(args) > 3 {
+		l.report(path, "INVALID_ARGS", "expected rules for keys, rules for values and optional max keys count")
+		return
+	}
+	if args[0] != nil {
+		l.lintChain(path+"[0]", args[0], nil)
+	}
+	if args[1] != nil {
+		l.lintChain(path+"[1]", args[1], nil)
+	}
+	if len(args) == 3 {
+		lintNumberArgs(1)(l, path, args[2:], scope)
+	}
+}
+
+func lintTuple(l *linter, path string, args []interface{}, scope Dictionary) {
+	for i, arg := range args {
+		opts, ok := arg.(Dictionary)
+		if !ok || i != len(args)-1 || !isOptions(opts, tupleOptions) {
+			l.lintChain(fmt.Sprintf("%s[%d]", path, i), arg, nil)
+			continue
+		}
+
+		switch v := opts["additional_items"].(type) {
+		case nil, bool:
+		default:
+			l.lintChain(path+".additional_items", v, nil)
+		}
+		for _, name := range []string{"min_items", "max_items"} {
+			if v, ok := opts[name]; ok {
+				lintNumberArgs(1)(l, path+"."+name, []interface{}{v}, scope)
+			}
+		}
+	}
+	if len(args) == 0 {
+		l.report(path, "INVALID_ARGS", "expected rules for list positions")
+	}
+}
+
+func lintVariableObject(l *linter, path string, args []interface{}, scope Dictionary) {
+	if len(args) != 2 {
+		l.report(path, "INVALID_ARGS", "expected selector field and object with rules per type")
+		return
+	}
+	if _, ok := args[0].(string); !ok {
+		l.report(path, "INVALID_ARGS", "expected selector field name, got %v", args[0])
+	}
+	variants, ok := args[1].(Dictionary)
+	if !ok {
+		l.report(path, "INVALID_ARGS", "expected object with rules per type")
+		return
+	}
+
+	names := make([]string, 0, len(variants))
+	for name := range variants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		rules, ok := variants[name].(Dictionary)
+		if !ok {
+			l.report(path+"."+name, "INVALID_ARGS", "expected object with rules")
+			continue
+		}
+		l.lintObject(path+"."+name, rules)
+	}
+}
+
+func numberArg(arg interface{}) (float64, bool) {
+	switch n := arg.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
+
+// suggestRule - return the closest registered rule name for misspelled one.
+func suggestRule(name string, builders map[string]Builder) string {
+	best, bestDist := "", len(name)/3+1
+	if bestDist < 3 {
+		bestDist = 3
+	}
+
+	for candidate := range builders {
+		d := levenshtein(name, candidate)
+		if d < bestDist || (d == bestDist && best != "" && candidate < best) {
+			best, bestDist = candidate, d
+		}
+	}
+
+	return best
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
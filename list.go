@@ -0,0 +1,245 @@
+package livr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// listLength - make sure that validated list has exact number of items,
+// e.g. {"list_length": 3}, or number of items in range, e.g. {"list_length": [1, 10]}.
+func listLength(args ...interface{}) Validation {
+	var sizes []float64
+	for _, arg := range args {
+		if v, ok := arg.(float64); ok {
+			sizes = append(sizes, v)
+		}
+	}
+	var minItems, maxItems float64
+	switch len(sizes) {
+	case 1:
+		minItems, maxItems = sizes[0], sizes[0]
+	case 2:
+		minItems, maxItems = sizes[0], sizes[1]
+	}
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+		if float64(len(items)) < minItems {
+			return nil, errors.New("TOO_FEW_ITEMS")
+		}
+		if float64(len(items)) > maxItems {
+			return nil, errors.New("TOO_MANY_ITEMS")
+		}
+
+		return value, nil
+	}
+}
+
+// listItemsUnique - make sure that validated list has no repeated items,
+// only lists of strings, numbers and booleans are supported.
+func listItemsUnique(args ...interface{}) Validation {
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		seen := make(map[valueKey]bool, len(items))
+		for _, item := range items {
+			key, ok := typedKey(item)
+			if !ok {
+				return nil, errors.New("FORMAT_ERROR")
+			}
+			if seen[key] {
+				return nil, errors.New("NOT_UNIQUE_ITEMS")
+			}
+			seen[key] = true
+		}
+
+		return value, nil
+	}
+}
+
+// uniqueBy - make sure that objects in validated list are unique by one or
+// more fields, e.g. {"unique_by": ["first_name", "last_name"]}. Repeated
+// objects get "NOT_UNIQUE" error for each of the fields. Objects where all of
+// the fields are absent are skipped.
+func uniqueBy(args ...interface{}) Validation {
+	fields := fieldArgs(args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		errs := make([]interface{}, len(items))
+		seen := make(map[string]bool, len(items))
+		var hasError bool
+		for i, item := range items {
+			object, ok := item.(Dictionary)
+			if !ok {
+				return nil, errors.New("FORMAT_ERROR")
+			}
+
+			parts := make([]string, len(fields))
+			absent := true
+			for j, field := range fields {
+				v, _ := lookupField(object, field)
+				key, ok := typedKey(v)
+				if !ok && v != nil {
+					return nil, errors.New("FORMAT_ERROR")
+				}
+				if !isEmpty(v) {
+					absent = false
+				}
+				parts[j] = fmt.Sprintf("%c%q", key.kind, key.s)
+			}
+			if absent {
+				// Objects without any of the fields are not compared.
+				continue
+			}
+
+			key := strings.Join(parts, ",")
+			if seen[key] {
+				hasError = true
+				errs[i] = fieldErrors(fields, "NOT_UNIQUE")
+			}
+			seen[key] = true
+		}
+
+		if hasError {
+			return nil, errs
+		}
+		return value, nil
+	}
+}
+
+// listContains - make sure that validated list contains all specified values.
+func listContains(args ...interface{}) Validation {
+	required := valueArgs(args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		for _, v := range required {
+			if !matchesAny(v, items) {
+				return nil, errors.New("MISSING_ITEMS")
+			}
+		}
+
+		return value, nil
+	}
+}
+
+// subsetOf - make sure that every item of validated list is one of
+// specified values.
+func subsetOf(args ...interface{}) Validation {
+	allowed := valueArgs(args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		errs := make([]interface{}, len(items))
+		var hasError bool
+		for i, item := range items {
+			if _, ok := scalarKey(item); !ok {
+				hasError = true
+				errs[i] = errors.New("FORMAT_ERROR")
+			} else if !matchesAny(item, allowed) {
+				hasError = true
+				errs[i] = errors.New("NOT_ALLOWED_VALUE")
+			}
+		}
+
+		if hasError {
+			return nil, errs
+		}
+		return value, nil
+	}
+}
+
+// valueArgs - return list of values from rule args, values can be passed as
+// args or as a single list.
+func valueArgs(args ...interface{}) []interface{} {
+	var values []interface{}
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case []interface{}:
+			values = append(values, v...)
+		case map[string]Builder:
+		default:
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// scalarKey - return string form of string, number or boolean value.
+func scalarKey(value interface{}) (string, bool) {
+	if f, ok := numberValue(value); ok {
+		return fmt.Sprint(f), true
+	}
+
+	switch value.(type) {
+	case string, float64, bool:
+		return fmt.Sprint(value), true
+	default:
+		return "", false
+	}
+}
+
+// valueKey - comparable form of string, number or boolean value. Values of
+// different JSON types have different keys, so "1" is not equal to 1.
+type valueKey struct {
+	kind byte
+	s    string
+}
+
+// typedKey - return key of string, number or boolean value, numbers of all Go
+// types share the same key.
+func typedKey(value interface{}) (valueKey, bool) {
+	key, ok := scalarKey(value)
+	if !ok {
+		return valueKey{}, false
+	}
+
+	switch value.(type) {
+	case string:
+		return valueKey{'s', key}, true
+	case bool:
+		return valueKey{'b', key}, true
+	default:
+		return valueKey{'n', key}, true
+	}
+}
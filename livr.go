@@ -2,8 +2,11 @@ package livr
 
 import (
 	"errors"
+	"fmt"
 	"log"
+	"sort"
 	"sync"
+	"time"
 )
 
 // Version is LIVR current semver
@@ -12,7 +15,8 @@ const Version = "2.0.0"
 // Dictionary - is dictionary alias.
 type Dictionary = map[string]interface{}
 
-// Validation - function in which made all validation checks.
+// Validation - function in which made all validation checks. Besides value it
+// gets object the value belongs to and already validated fields of that object.
 type Validation = func(interface{}, ...interface{}) (interface{}, interface{})
 
 // Builder - common type for building validators.
@@ -26,15 +30,44 @@ type Validator struct {
 	validators        map[string][]Validation
 	validatorBuilders map[string]Builder
 
+	// fields - order of fields validation, fields referenced by other fields
+	// rules go first.
+	fields []string
+	// objectValidators - rules for the whole object, see ObjectRulesKey.
+	objectValidators []Validation
+	// nullable - fields that accept explicit null.
+	nullable map[string]bool
+	// fieldRefRules - custom rules that reference other field, see
+	// RegisterFieldRefRules.
+	fieldRefRules map[string]bool
+	// buildErr - error of broken rules, every later validation panics with it.
+	buildErr error
+
 	errs map[string]interface{}
 
-	isAutoTrim bool
+	isAutoTrim  bool
+	emptyString emptyString
 }
 
+// ObjectRulesKey - reserved rules key for object level rules. They are
+// applied to the validated output after all field rules passed and errors of
+// all object rules are reported, e.g.
+// {"$object": {"at_least_one_of": ["email", "phone"]}}.
+const ObjectRulesKey = "$object"
+
 // Options - config for validator instance.
 type Options struct {
 	LivrRules Dictionary
 	AutoTrim  isAutoTrim
+	// EmptyString - how empty strings of input are treated, see EmptyStringValue.
+	EmptyString emptyString
+	// Strict - numeric and length rules reject values of other JSON types
+	// instead of coercing them, see typedRules.
+	Strict bool
+	// IntegerOutput - type of integer rules output, see IntegerFloat64.
+	IntegerOutput integerOutput
+	// Now - clock of rules relative to current time, time.Now by default.
+	Now func() time.Time
 }
 
 var defaultRules map[string]Builder
@@ -46,6 +79,30 @@ func init() {
 		"not_empty":      notEmpty,
 		"not_empty_list": notEmptyList,
 		"any_object":     anyObject,
+		"nullable":       nullable,
+
+		// Boolean rules.
+		"boolean": boolean,
+		"is":      is,
+
+		// List rules.
+		"list_length":       listLength,
+		"list_items_unique": listItemsUnique,
+		"unique_by":         uniqueBy,
+		"list_contains":     listContains,
+		"subset_of":         subsetOf,
+
+		// Conditional requirement rules.
+		"required_if":      requiredIf,
+		"required_unless":  requiredUnless,
+		"required_with":    requiredWith,
+		"required_without": requiredWithout,
+
+		// Object rules, should be used under ObjectRulesKey.
+		"at_least_one_of":    atLeastOneOf,
+		"exactly_one_of":     exactlyOneOf,
+		"mutually_exclusive": mutuallyExclusive,
+		"all_or_none":        allOrNone,
 
 		// Text related rules.
 		"one_of":         oneOf,
@@ -72,6 +129,62 @@ func init() {
 		"url":            url,
 		"iso_date":       isoDate,
 
+		// Date rules.
+		"iso_datetime": isoDatetime,
+		"min_date":     minDate(time.Now),
+		"max_date":     maxDate(time.Now),
+		"date_between": dateBetween(time.Now),
+
+		// Cross-field comparison rules.
+		"greater_than_field": greaterThanField,
+		"less_than_field":    lessThanField,
+		"not_equal_to_field": notEqualToField,
+		"after_field":        afterField,
+		"before_field":       beforeField,
+
+		// Configuration format rules.
+		"duration": duration,
+		"cron":     cron,
+		"semver":   semVer,
+
+		// Finance rules.
+		"credit_card": creditCard,
+		"iban":        iban,
+		"bic":         bic,
+
+		// Currency rules.
+		"currency":            currency,
+		"amount_for_currency": amountForCurrency,
+
+		// Locale rules.
+		"country":      country,
+		"language":     language,
+		"language_tag": languageTag,
+		"time_zone":    timeZone,
+
+		// Phone rules.
+		"phone": phone,
+
+		// Network rules.
+		"ipv4":        ipv4,
+		"ipv6":        ipv6,
+		"ip":          ip,
+		"cidr":        cidr,
+		"mac_address": macAddress,
+		"hostname":    hostname,
+		"port":        port,
+
+		// Identifier rules.
+		"uuid":      uuid,
+		"ulid":      ulid,
+		"mongo_id":  mongoID,
+		"md5":       md5,
+		"sha1":      sha1,
+		"sha256":    sha256,
+		"sha512":    sha512,
+		"base64":    _base64,
+		"base64url": base64URL,
+
 		// Meta rules.
 		"nested_object":             nestedObject,
 		"list_of":                   listOf,
@@ -79,6 +192,11 @@ func init() {
 		"list_of_different_objects": listOfDifferentObjects,
 		"variable_object":           variableObject,
 		"or":                        or,
+		"and":                       and,
+		"not":                       not,
+		"if":                        _if,
+		"map_of":                    mapOf,
+		"tuple":                     tuple,
 
 		// Modifires - allows to change or sanitize value.
 		"default":    _default,
@@ -87,6 +205,9 @@ func init() {
 		"to_uc":      toUc,
 		"remove":     remove,
 		"leave_only": leaveOnly,
+
+		// Timestamp modifiers.
+		"normalize_timestamp": normalizeTimestamp,
 	}
 }
 
@@ -100,10 +221,29 @@ func New(opts *Options) *Validator {
 		livrRules:         opts.LivrRules,
 		validatorBuilders: make(map[string]Builder),
 		validators:        make(map[string][]Validation),
+		nullable:          make(map[string]bool),
 		isAutoTrim:        at,
+		emptyString:       opts.EmptyString,
 	}
 
 	v.registerRules(defaultRules)
+	if opts.Now != nil {
+		for name, rule := range clockRules {
+			v.validatorBuilders[name] = rule(opts.Now)
+		}
+	}
+	if opts.IntegerOutput != IntegerFloat64 {
+		for _, name := range integerRules {
+			if builder, ok := v.validatorBuilders[name]; ok {
+				v.validatorBuilders[name] = integerBuilder(builder, opts.IntegerOutput)
+			}
+		}
+	}
+	for name, typed := range typedRules {
+		if builder, ok := v.validatorBuilders[name]; ok {
+			v.validatorBuilders[name] = strictBuilder(builder, typed, opts.Strict)
+		}
+	}
 
 	return v
 }
@@ -181,6 +321,28 @@ func (v *Validator) Rules() map[string][]Validation {
 	return v.validators
 }
 
+// RegisterRules - register custom user rules for validator instance.
+// Fields are validated in name order, so a rule that reads validated value of
+// other field must be marked with RegisterFieldRefRules to get that field
+// validated before.
+func (v *Validator) RegisterRules(rules map[string]Builder) {
+	v.registerRules(rules)
+}
+
+// RegisterFieldRefRules - mark rules which first argument is name of other
+// field of the same object, e.g. {"my_rule": "start"}. Field "start" is then
+// validated before fields that use the rule, even inside "or", "and", "not"
+// and "if" chains. Marks apply to rules of this validator only, fields of
+// nested objects are ordered by built-in rules.
+func (v *Validator) RegisterFieldRefRules(names ...string) {
+	if v.fieldRefRules == nil {
+		v.fieldRefRules = make(map[string]bool, len(names))
+	}
+	for _, name := range names {
+		v.fieldRefRules[name] = true
+	}
+}
+
 func (v *Validator) registerRules(rules map[string]Builder) {
 	for name, r := range rules {
 		v.validatorBuilders[name] = r
@@ -191,6 +353,10 @@ func (v *Validator) registerRules(rules map[string]Builder) {
 func (v *Validator) Validate(data Dictionary) (Dictionary, error) {
 	v.prepare()
 
+	if v.emptyString != EmptyStringValue {
+		data = normalizeEmpty(data, v.emptyString).(Dictionary)
+	}
+
 	res := v.validate(data)
 	if res == nil {
 		return nil, errors.New("validation error")
@@ -204,32 +370,65 @@ func (v *Validator) Errors() Dictionary {
 	return v.errs
 }
 
+// ErrorCodes - convert validation errors to a tree of error codes, as it
+// described by LIVR specification.
+func ErrorCodes(errs interface{}) interface{} {
+	switch e := errs.(type) {
+	case *NoMatchError:
+		return Dictionary{"code": e.Error(), "errors": ErrorCodes(e.Errors)}
+	case map[string]interface{}:
+		codes := make(map[string]interface{}, len(e))
+		for k, err := range e {
+			codes[k] = ErrorCodes(err)
+		}
+		return codes
+	case []interface{}:
+		codes := make([]interface{}, len(e))
+		for i, err := range e {
+			codes[i] = ErrorCodes(err)
+		}
+		return codes
+	case error:
+		return e.Error()
+	default:
+		return e
+	}
+}
+
 func (v *Validator) validate(data Dictionary) Dictionary {
 	results := make(Dictionary)
 	errors := make(Dictionary)
 
-	for fName, validators := range v.validators {
+	for _, fName := range v.fields {
+		validators := v.validators[fName]
 		if len(validators) == 0 {
 			continue
 		}
 
-		var val interface{}
-		if _, ok := data[fName]; ok {
-			val = data[fName]
+		if err, _ := applyRules(fName, validators, v.nullable[fName], data, results); err != nil {
+			errors[fName] = err
 		}
+	}
 
-		for _, validator := range validators {
-			if v, ok := results[fName]; ok {
-				val = v
-			}
-			res, err := validator(val, data)
+	if len(errors) == 0 {
+		for _, validator := range v.objectValidators {
+			res, err := validator(results, data, results)
 			if err != nil {
-				errors[fName] = err
-				break
-			} else if res != nil {
-				results[fName] = res
-			} else if _, ok := data[fName]; ok {
-				results[fName] = val
+				// Object rules report errors per field, other errors are
+				// kept under reserved key. The first error of field wins.
+				fieldErrs, ok := err.(Dictionary)
+				if !ok {
+					fieldErrs = Dictionary{ObjectRulesKey: err}
+				}
+				for fName, fErr := range fieldErrs {
+					if _, ok := errors[fName]; !ok {
+						errors[fName] = fErr
+					}
+				}
+				continue
+			}
+			if r, ok := res.(Dictionary); ok {
+				results = r
 			}
 		}
 	}
@@ -244,8 +443,61 @@ func (v *Validator) validate(data Dictionary) Dictionary {
 	return results
 }
 
+// applyRules - apply rules to field one by one until the first error, return
+// the error and number of passed rules. Explicit null of nullable field skips
+// all rules.
+func applyRules(fName string, validators []Validation, nullable bool, data, results Dictionary) (interface{}, int) {
+	var val interface{}
+	if _, ok := data[fName]; ok {
+		val = data[fName]
+		if val == nil && nullable {
+			results[fName] = nil
+			return nil, len(validators)
+		}
+	}
+
+	for i, validator := range validators {
+		if v, ok := results[fName]; ok {
+			val = v
+		}
+		res, err := validator(val, data, results)
+		if err != nil {
+			return err, i
+		} else if res != nil {
+			results[fName] = res
+		} else if _, ok := data[fName]; ok {
+			results[fName] = val
+		}
+	}
+
+	return nil, len(validators)
+}
+
+// Prepare - build validators for all rules and report broken rules as error
+// instead of panic. Validator with broken rules stays unusable, every later
+// Validate panics with the same error.
+func (v *Validator) Prepare() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	v.prepare()
+
+	return nil
+}
+
 func (v *Validator) prepare() {
 	v.once.Do(func() {
+		defer func() {
+			if r := recover(); r != nil {
+				v.buildErr = fmt.Errorf("%v", r)
+				v.validators = make(map[string][]Validation)
+				v.objectValidators = nil
+			}
+		}()
+
 		for field, fieldRules := range v.livrRules {
 			if _, ok := fieldRules.([]interface{}); !ok {
 				fieldRules = []interface{}{fieldRules}
@@ -255,9 +507,103 @@ func (v *Validator) prepare() {
 				name, args := parseRule(rawRule)
 				validators = append(validators, v.buildValidator(name, args))
 			}
+			if field == ObjectRulesKey {
+				v.objectValidators = validators
+				continue
+			}
 			v.validators[field] = validators
+			v.nullable[field] = hasNullable(fieldRules.([]interface{}))
 		}
+		v.fields = fieldsOrder(v.livrRules, v.fieldRefRules)
 	})
+
+	if v.buildErr != nil {
+		panic(v.buildErr)
+	}
+}
+
+// fieldRefRules - rules that compare value with validated value of field
+// passed as first argument, so that field must be validated before.
+var fieldRefRules = map[string]bool{
+	"greater_than_field": true,
+	"less_than_field":    true,
+	"not_equal_to_field": true,
+	"after_field":        true,
+	"before_field":       true,
+
+	"amount_for_currency": true,
+}
+
+// chainRules - rules which arguments are rules chains applied to the same
+// value, so field references are looked up inside them.
+var chainRules = map[string]bool{
+	"or":  true,
+	"and": true,
+	"not": true,
+	"if":  true,
+}
+
+// fieldsOrder - return fields sorted by name with dependencies moved before
+// fields that depend on them. Dependencies are found by fieldRefRules and
+// custom refRules. Fields in dependency cycle keep name order.
+func fieldsOrder(rules Dictionary, refRules map[string]bool) []string {
+	names := make([]string, 0, len(rules))
+	for field := range rules {
+		if field != ObjectRulesKey {
+			names = append(names, field)
+		}
+	}
+	sort.Strings(names)
+
+	deps := make(map[string][]string)
+	for _, field := range names {
+		var collect func(lr interface{})
+		collect = func(lr interface{}) {
+			if chain, ok := lr.([]interface{}); ok {
+				for _, rule := range chain {
+					collect(rule)
+				}
+				return
+			}
+
+			name, args := parseRule(lr)
+			if chainRules[name] {
+				for _, arg := range args {
+					collect(arg)
+				}
+				return
+			}
+			if !fieldRefRules[name] && !refRules[name] {
+				return
+			}
+			if dep, ok := firstArg(args...).(string); ok && dep != field {
+				if _, ok := rules[dep]; ok {
+					deps[field] = append(deps[field], dep)
+				}
+			}
+		}
+		collect(rules[field])
+	}
+
+	var order []string
+	state := make(map[string]int) // 1 - in progress, 2 - done.
+	var visit func(field string)
+	visit = func(field string) {
+		if state[field] != 0 {
+			return
+		}
+		state[field] = 1
+		for _, dep := range deps[field] {
+			visit(dep)
+		}
+		state[field] = 2
+		order = append(order, field)
+	}
+	for _, field := range names {
+		visit(field)
+	}
+
+	return order
 }
 
 func (v *Validator) buildValidator(name string, args []interface{}) Validation {
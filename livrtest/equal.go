@@ -0,0 +1,175 @@
+package livrtest
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// visit - pair of compared references, used to stop on cyclic values.
+type visit struct {
+	a1  uintptr
+	a2  uintptr
+	typ reflect.Type
+}
+
+// hard - kinds that are compared by content and can't be duck typed.
+func hard(k reflect.Kind) bool {
+	switch k {
+	case reflect.Map, reflect.Slice, reflect.Ptr, reflect.Interface:
+		return true
+	}
+	return false
+}
+
+func deepValueEqual(v1, v2 reflect.Value, visited map[visit]bool, depth int) bool {
+	if v1.Kind() != v2.Kind() && (hard(v1.Kind()) || hard(v2.Kind())) {
+		return false
+	}
+
+	switch v1.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Ptr:
+		// Pointer does not need addressable values, unlike UnsafeAddr.
+		addr1, addr2 := v1.Pointer(), v2.Pointer()
+		if addr1 > addr2 {
+			// Canonicalize order to reduce number of entries in visited.
+			addr1, addr2 = addr2, addr1
+		}
+
+		// Short circuit if references are already seen.
+		v := visit{addr1, addr2, v1.Type()}
+		if visited[v] {
+			return true
+		}
+
+		// Remember for later.
+		visited[v] = true
+	}
+
+	switch v1.Kind() {
+	case reflect.Slice:
+		if v1.Len() != v2.Len() {
+			return false
+		}
+		if v1.Pointer() == v2.Pointer() {
+			return true
+		}
+		for i := 0; i < v1.Len(); i++ {
+			if !deepValueEqual(v1.Index(i), v2.Index(i), visited, depth+1) {
+				return false
+			}
+		}
+		return true
+	case reflect.Interface:
+		if v1.IsNil() || v2.IsNil() {
+			return v1.IsNil() == v2.IsNil()
+		}
+		return deepValueEqual(v1.Elem(), v2.Elem(), visited, depth+1)
+	case reflect.Map:
+		if v1.Len() != v2.Len() {
+			return false
+		}
+		if v1.Pointer() == v2.Pointer() {
+			return true
+		}
+		if v1.Type().Key() != v2.Type().Key() {
+			return false
+		}
+		for _, k := range v1.MapKeys() {
+			val1 := v1.MapIndex(k)
+			val2 := v2.MapIndex(k)
+			if !val1.IsValid() || !val2.IsValid() || !deepValueEqual(v1.MapIndex(k), v2.MapIndex(k), visited, depth+1) {
+				return false
+			}
+		}
+		return true
+	case reflect.Ptr:
+		if v1.Pointer() == v2.Pointer() {
+			return true
+		}
+		return deepValueEqual(v1.Elem(), v2.Elem(), visited, depth+1)
+	default:
+		if n, ok := number(v1); ok {
+			return numberEqual(n, v1, v2)
+		}
+		if n, ok := number(v2); ok {
+			return numberEqual(n, v2, v1)
+		}
+
+		switch v1.Kind() {
+		case reflect.Bool:
+			switch v2.Kind() {
+			case reflect.Bool:
+				return v2.Bool() == v1.Bool()
+			case reflect.String:
+				if b, err := strconv.ParseBool(v2.String()); err == nil {
+					return v1.Bool() == b
+				}
+				return false
+			}
+		case reflect.String:
+			switch v2.Kind() {
+			case reflect.Bool:
+				return strconv.FormatBool(v2.Bool()) == v1.String()
+			case reflect.String:
+				return v1.String() == v2.String()
+			}
+		}
+		return false
+	}
+}
+
+// number - return value of integer or float kind as float64.
+func number(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// numberEqual - compare number n of value v with other value by number value,
+// so 1.0, int64(1), "1" and json.Number("1") are equal. Integers are compared
+// exactly.
+func numberEqual(n float64, v, other reflect.Value) bool {
+	isInt := func(v reflect.Value) bool {
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return true
+		}
+		return false
+	}
+
+	if other.Kind() == reflect.String {
+		if isInt(v) {
+			if i, err := strconv.ParseInt(other.String(), 10, 64); err == nil {
+				return i == v.Int()
+			}
+		}
+		f, err := strconv.ParseFloat(other.String(), 64)
+		return err == nil && f == n
+	}
+
+	if isInt(v) && isInt(other) {
+		return v.Int() == other.Int()
+	}
+	m, ok := number(other)
+	return ok && m == n
+}
+
+// JSONDuckEqual reports if two map[string]interface{} value are equal.
+// On equality skip type checks and try to cast leaf to the same basic type.
+func JSONDuckEqual(x, y interface{}) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	v1 := reflect.ValueOf(x)
+	v2 := reflect.ValueOf(y)
+	if v1.Type() != v2.Type() {
+		return false
+	}
+	return deepValueEqual(v1, v2, make(map[visit]bool), 0)
+}
@@ -0,0 +1,166 @@
+// Package livrtest runs LIVR specification style test cases as Go subtests.
+//
+// Every directory that contains "rules.json" is a test case. Besides rules
+// case directory contains "input.json" and either "output.json" (for cases
+// that must pass) or "errors.json" (for cases that must fail). Optional
+// "aliases.json" holds a list of aliases registered before validation.
+//
+// The layout matches LIVR test suite, so both the official suite and custom
+// cases can be run with the same harness:
+//
+//	func TestRules(t *testing.T) {
+//		livrtest.Run(t, "testdata", &livrtest.Registry{
+//			Rules: map[string]livr.Builder{"my_rule": myRule},
+//		})
+//	}
+package livrtest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/k33nice/go-livr"
+)
+
+// Registry - custom rules, aliases and validator options used for every test
+// case. LivrRules of Options are replaced with rules of the case.
+type Registry struct {
+	Rules   map[string]livr.Builder
+	Aliases []livr.Alias
+	Options *livr.Options
+}
+
+type testCase struct {
+	rules   livr.Dictionary
+	input   livr.Dictionary
+	output  livr.Dictionary
+	errors  interface{}
+	aliases []livr.Alias
+
+	negative bool
+}
+
+// Run - run every test case found in dir as subtest.
+func Run(t *testing.T, dir string, r *Registry) {
+	t.Helper()
+
+	dirs, err := caseDirs(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) == 0 {
+		t.Fatalf("no test cases found in %s", dir)
+	}
+
+	for _, caseDir := range dirs {
+		name, err := filepath.Rel(dir, caseDir)
+		if err != nil {
+			name = caseDir
+		}
+
+		caseDir := caseDir
+		t.Run(filepath.ToSlash(name), func(t *testing.T) {
+			c, err := loadCase(caseDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			runCase(t, c, r)
+		})
+	}
+}
+
+func runCase(t *testing.T, c *testCase, r *Registry) {
+	var opts livr.Options
+	if r != nil && r.Options != nil {
+		opts = *r.Options
+	}
+	opts.LivrRules = c.rules
+
+	v := livr.New(&opts)
+	if r != nil {
+		v.RegisterRules(r.Rules)
+		for _, a := range r.Aliases {
+			v.RegisterAliasedRule(a)
+		}
+	}
+	for _, a := range c.aliases {
+		v.RegisterAliasedRule(a)
+	}
+
+	out, err := v.Validate(c.input)
+	if c.negative {
+		if err == nil {
+			t.Fatalf("validation pass but must fail, output: %v", out)
+		}
+		if errs := livr.ErrorCodes(v.Errors()); !JSONDuckEqual(c.errors, errs) {
+			t.Errorf("unexpected errors\n got: %v\nwant: %v", errs, c.errors)
+		}
+		return
+	}
+
+	if err != nil {
+		t.Fatalf("validation fail but must pass, errors: %v", livr.ErrorCodes(v.Errors()))
+	}
+	if !JSONDuckEqual(c.output, out) {
+		t.Errorf("unexpected output\n got: %v\nwant: %v", out, c.output)
+	}
+}
+
+// caseDirs - return all directories under root that contain rules.
+func caseDirs(root string) ([]string, error) {
+	var dirs []string
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && info.Name() == "rules.json" {
+			dirs = append(dirs, filepath.Dir(path))
+		}
+		return nil
+	})
+
+	return dirs, err
+}
+
+func loadCase(dir string) (*testCase, error) {
+	c := &testCase{}
+	if err := readJSON(dir, "rules.json", &c.rules); err != nil {
+		return nil, err
+	}
+	if err := readJSON(dir, "input.json", &c.input); err != nil {
+		return nil, err
+	}
+
+	if exists(dir, "errors.json") {
+		c.negative = true
+		if err := readJSON(dir, "errors.json", &c.errors); err != nil {
+			return nil, err
+		}
+	} else if err := readJSON(dir, "output.json", &c.output); err != nil {
+		return nil, err
+	}
+
+	if exists(dir, "aliases.json") {
+		if err := readJSON(dir, "aliases.json", &c.aliases); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func readJSON(dir, name string, v interface{}) error {
+	data, err := ioutil.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func exists(dir, name string) bool {
+	_, err := os.Stat(filepath.Join(dir, name))
+	return err == nil
+}
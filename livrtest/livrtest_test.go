@@ -0,0 +1,89 @@
+package livrtest_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/k33nice/go-livr"
+	"github.com/k33nice/go-livr/livrtest"
+)
+
+// even - custom rule that makes sure validated value is even number.
+func even(args ...interface{}) livr.Validation {
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		var n float64
+		switch v := value.(type) {
+		case float64:
+			n = v
+		case string:
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, errors.New("NOT_EVEN")
+			}
+			n = f
+		default:
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		if int64(n)%2 != 0 || n != float64(int64(n)) {
+			return nil, errors.New("NOT_EVEN")
+		}
+
+		return n, nil
+	}
+}
+
+func TestRun(t *testing.T) {
+	livrtest.Run(t, "testdata", &livrtest.Registry{
+		Rules: map[string]livr.Builder{"even": even},
+		Aliases: []livr.Alias{{
+			Name:  "adult_age",
+			Rules: []interface{}{"positive_integer", livr.Dictionary{"min_number": 18.0}},
+		}},
+	})
+}
+
+func TestRunOptions(t *testing.T) {
+	livrtest.Run(t, "testdata/aliases_positive", &livrtest.Registry{
+		Aliases: []livr.Alias{{
+			Name:  "adult_age",
+			Rules: []interface{}{"positive_integer", livr.Dictionary{"min_number": 18.0}},
+		}},
+		Options: &livr.Options{IntegerOutput: livr.IntegerInt64},
+	})
+}
+
+func TestJSONDuckEqual(t *testing.T) {
+	cyclic := livr.Dictionary{"name": "x"}
+	cyclic["self"] = cyclic
+
+	cases := []struct {
+		x, y  interface{}
+		equal bool
+	}{
+		{livr.Dictionary{"a": 1.0, "b": true}, livr.Dictionary{"a": "1", "b": "true"}, true},
+		{livr.Dictionary{"list": []interface{}{1.0, "x"}}, livr.Dictionary{"list": []interface{}{"1", "x"}}, true},
+		{livr.Dictionary{"a": 1.0}, livr.Dictionary{"a": 2.0}, false},
+		{livr.Dictionary{"a": true}, livr.Dictionary{"a": &cyclic}, false},
+		{cyclic, cyclic, true},
+		{livr.Dictionary{"a": livr.Dictionary{"b": "X"}}, livr.Dictionary{"a": 1.0}, false},
+		{livr.Dictionary{"a": 1.0}, livr.Dictionary{"a": livr.Dictionary{"b": "X"}}, false},
+		{livr.Dictionary{"a": []interface{}{1.0}}, livr.Dictionary{"a": true}, false},
+		{livr.Dictionary{"a": 42.0}, livr.Dictionary{"a": int64(42)}, true},
+		{livr.Dictionary{"a": "9007199254740993"}, livr.Dictionary{"a": int64(9007199254740993)}, true},
+		{livr.Dictionary{"a": 42.0}, livr.Dictionary{"a": json.Number("42")}, true},
+		{livr.Dictionary{"a": 42.0}, livr.Dictionary{"a": int64(43)}, false},
+	}
+
+	for i, c := range cases {
+		if got := livrtest.JSONDuckEqual(c.x, c.y); got != c.equal {
+			t.Errorf("case %d: got %v, want %v", i, got, c.equal)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+package livr
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// countryCodes - ISO 3166-1 alpha-2 codes with alpha-3 equivalents.
+var countryCodes = pairs(`
+	AD AND AE ARE AF AFG AG ATG AI AIA AL ALB AM ARM AO AGO AQ ATA AR ARG AS ASM AT AUT AU AUS AW ABW AX ALA
+	AZ AZE BA BIH BB BRB BD BGD BE BEL BF BFA BG BGR BH BHR BI BDI BJ BEN BL BLM BM BMU BN BRN BO BOL BQ BES
+	BR BRA BS BHS BT BTN BV BVT BW BWA BY BLR BZ BLZ CA CAN CC CCK CD COD CF CAF CG COG CH CHE CI CIV CK COK
+	CL CHL CM CMR CN CHN CO COL CR CRI CU CUB CV CPV CW CUW CX CXR CY CYP CZ CZE DE DEU DJ DJI DK DNK DM DMA
+	DO DOM DZ DZA EC ECU EE EST EG EGY EH ESH ER ERI ES ESP ET ETH FI FIN FJ FJI FK FLK FM FSM FO FRO FR FRA
+	GA GAB GB GBR GD GRD GE GEO GF GUF GG GGY GH GHA GI GIB GL GRL GM GMB GN GIN GP GLP GQ GNQ GR GRC GS SGS
+	GT GTM GU GUM GW GNB GY GUY HK HKG HM HMD HN HND HR HRV HT HTI HU HUN ID IDN IE IRL IL ISR IM IMN IN IND
+	IO IOT IQ IRQ IR IRN IS ISL IT ITA JE JEY JM JAM JO JOR JP JPN KE KEN KG KGZ KH KHM KI KIR KM COM KN KNA
+	KP PRK KR KOR KW KWT KY CYM KZ KAZ LA LAO LB LBN LC LCA LI LIE LK LKA LR LBR LS LSO LT LTU LU LUX LV LVA
+	LY LBY MA MAR MC MCO MD MDA ME MNE MF MAF MG MDG MH MHL MK MKD ML MLI MM MMR MN MNG MO MAC MP MNP MQ MTQ
+	MR MRT MS MSR MT MLT MU MUS MV MDV MW MWI MX MEX MY MYS MZ MOZ NA NAM NC NCL NE NER NF NFK NG NGA NI NIC
+	NL NLD NO NOR NP NPL NR NRU NU NIU NZ NZL OM OMN PA PAN PE PER PF PYF PG PNG PH PHL PK PAK PL POL PM SPM
+	PN PCN PR PRI PS PSE PT PRT PW PLW PY PRY QA QAT RE REU RO ROU RS SRB RU RUS RW RWA SA SAU SB SLB SC SYC
+	SD SDN SE SWE SG SGP SH SHN SI SVN SJ SJM SK SVK SL SLE SM SMR SN SEN SO SOM SR SUR SS SSD ST STP SV SLV
+	SX SXM SY SYR SZ SWZ TC TCA TD TCD TF ATF TG TGO TH THA TJ TJK TK TKL TL TLS TM TKM TN TUN TO TON TR TUR
+	TT TTO TV TUV TW TWN TZ TZA UA UKR UG UGA UM UMI US USA UY URY UZ UZB VA VAT VC VCT VE VEN VG VGB VI VIR
+	VN VNM VU VUT WF WLF WS WSM YE YEM YT MYT ZA ZAF ZM ZMB ZW ZWE`)
+
+// languageCodes - ISO 639-1 codes with ISO 639-2/T equivalents.
+var languageCodes = pairs(`
+	aa aar ab abk ae ave af afr ak aka am amh an arg ar ara as asm av ava ay aym az aze ba bak be bel bg bul
+	bi bis bm bam bn ben bo bod br bre bs bos ca cat ce che ch cha co cos cr cre cs ces cu chu cv chv cy cym
+	da dan de deu dv div dz dzo ee ewe el ell en eng eo epo es spa et est eu eus fa fas ff ful fi fin fj fij
+	fo fao fr fra fy fry ga gle gd gla gl glg gn grn gu guj gv glv ha hau he heb hi hin ho hmo hr hrv ht hat
+	hu hun hy hye hz her ia ina id ind ie ile ig ibo ii iii ik ipk io ido is isl it ita iu iku ja jpn jv jav
+	ka kat kg kon ki kik kj kua kk kaz kl kal km khm kn kan ko kor kr kau ks kas ku kur kv kom kw cor ky kir
+	la lat lb ltz lg lug li lim ln lin lo lao lt lit lu lub lv lav mg mlg mh mah mi mri mk mkd ml mal mn mon
+	mr mar ms msa mt mlt my mya na nau nb nob nd nde ne nep ng ndo nl nld nn nno no nor nr nbl nv nav ny nya
+	oc oci oj oji om orm or ori os oss pa pan pi pli pl pol ps pus pt por qu que rm roh rn run ro ron ru rus
+	rw kin sa san sc srd sd snd se sme sg sag si sin sk slk sl slv sm smo sn sna so som sq sqi sr srp ss ssw
+	st sot su sun sv swe sw swa ta tam te tel tg tgk th tha ti tir tk tuk tl tgl tn tsn to ton tr tur ts tso
+	tt tat tw twi ty tah ug uig uk ukr ur urd uz uzb ve ven vi vie vo vol wa wln wo wol xh xho yi yid yo yor
+	za zha zh zho zu zul`)
+
+// codeTable - alpha-2 codes and their alpha-3 equivalents.
+type codeTable struct {
+	alpha2, alpha3 map[string]bool
+}
+
+func pairs(s string) codeTable {
+	t := codeTable{alpha2: make(map[string]bool), alpha3: make(map[string]bool)}
+	fields := strings.Fields(s)
+	for i := 0; i+1 < len(fields); i += 2 {
+		t.alpha2[fields[i]] = true
+		t.alpha3[fields[i+1]] = true
+	}
+	return t
+}
+
+// codeRule - check code in table, "alpha2" and "alpha3" flags set accepted
+// forms (alpha-2 by default), "canonical" flag converts output case.
+func codeRule(table codeTable, canonical func(string) string, errCode string, args ...interface{}) Validation {
+	alpha2, alpha3 := hasFlag("alpha2", args...), hasFlag("alpha3", args...)
+	if !alpha2 && !alpha3 {
+		alpha2 = true
+	}
+	isCanonical := hasFlag("canonical", args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+		code := canonical(v)
+		if !(alpha2 && table.alpha2[code]) && !(alpha3 && table.alpha3[code]) {
+			return nil, errors.New(errCode)
+		}
+
+		if isCanonical {
+			return code, nil
+		}
+		return v, nil
+	}
+}
+
+// country - make sure that validated value is ISO 3166-1 country code,
+// e.g. {"country": ["alpha2", "alpha3", "canonical"]}. Canonical code is upper
+// case.
+func country(args ...interface{}) Validation {
+	return codeRule(countryCodes, strings.ToUpper, "NOT_COUNTRY", args...)
+}
+
+// language - make sure that validated value is ISO 639-1 (alpha-2) or ISO
+// 639-2 (alpha-3) language code. Canonical code is lower case.
+func language(args ...interface{}) Validation {
+	return codeRule(languageCodes, strings.ToLower, "NOT_LANGUAGE", args...)
+}
+
+// irregularLanguageTags - grandfathered BCP 47 tags that don't match the
+// language tag syntax.
+var irregularLanguageTags = map[string]string{
+	"en-gb-oed": "en-GB-oed", "i-ami": "i-ami", "i-bnn": "i-bnn", "i-default": "i-default",
+	"i-enochian": "i-enochian", "i-hak": "i-hak", "i-klingon": "i-klingon", "i-lux": "i-lux",
+	"i-mingo": "i-mingo", "i-navajo": "i-navajo", "i-pwn": "i-pwn", "i-tao": "i-tao", "i-tay": "i-tay",
+	"i-tsu": "i-tsu", "sgn-be-fr": "sgn-BE-FR", "sgn-be-nl": "sgn-BE-NL", "sgn-ch-de": "sgn-CH-DE",
+}
+
+// languageTag - make sure that validated value is well-formed BCP 47
+// language tag, e.g. "en-US" or "zh-Hant-TW". Canonical tag has lower case
+// language, title case script and upper case region.
+func languageTag(args ...interface{}) Validation {
+	isCanonical := hasFlag("canonical", args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+		tag, ok := parseLanguageTag(v)
+		if !ok {
+			return nil, errors.New("NOT_LANGUAGE_TAG")
+		}
+
+		if isCanonical {
+			return tag, nil
+		}
+		return v, nil
+	}
+}
+
+// parseLanguageTag - check tag syntax from RFC 5646 and return it in
+// canonical case.
+func parseLanguageTag(s string) (string, bool) {
+	lower := strings.ToLower(s)
+	if tag, ok := irregularLanguageTags[lower]; ok {
+		return tag, true
+	}
+
+	subtags := strings.Split(lower, "-")
+	if subtags[0] == "x" {
+		return lower, privateUse(subtags[1:])
+	}
+
+	// Language with up to three extended language subtags.
+	lang := subtags[0]
+	if !isAlpha(lang) || len(lang) < 2 || len(lang) > 8 {
+		return "", false
+	}
+	i := 1
+	if len(lang) <= 3 {
+		for n := 0; n < 3 && i < len(subtags) && len(subtags[i]) == 3 && isAlpha(subtags[i]); n++ {
+			i++
+		}
+	}
+
+	// Script.
+	if i < len(subtags) && len(subtags[i]) == 4 && isAlpha(subtags[i]) {
+		subtags[i] = strings.ToUpper(subtags[i][:1]) + subtags[i][1:]
+		i++
+	}
+
+	// Region.
+	if i < len(subtags) && ((len(subtags[i]) == 2 && isAlpha(subtags[i])) || (len(subtags[i]) == 3 && isDigits(subtags[i]))) {
+		subtags[i] = strings.ToUpper(subtags[i])
+		i++
+	}
+
+	// Variants.
+	for i < len(subtags) && isVariant(subtags[i]) {
+		i++
+	}
+
+	// Extensions.
+	for i < len(subtags) && len(subtags[i]) == 1 && subtags[i] != "x" && isAlphanum(subtags[i]) {
+		i++
+		start := i
+		for i < len(subtags) && len(subtags[i]) >= 2 && len(subtags[i]) <= 8 && isAlphanum(subtags[i]) {
+			i++
+		}
+		if i == start {
+			return "", false
+		}
+	}
+
+	if i < len(subtags) {
+		if subtags[i] != "x" || !privateUse(subtags[i+1:]) {
+			return "", false
+		}
+	}
+
+	return strings.Join(subtags, "-"), true
+}
+
+func privateUse(subtags []string) bool {
+	if len(subtags) == 0 {
+		return false
+	}
+	for _, s := range subtags {
+		if len(s) < 1 || len(s) > 8 || !isAlphanum(s) {
+			return false
+		}
+	}
+	return true
+}
+
+func isVariant(s string) bool {
+	if !isAlphanum(s) {
+		return false
+	}
+	return (len(s) >= 5 && len(s) <= 8) || (len(s) == 4 && s[0] >= '0' && s[0] <= '9')
+}
+
+func isAlpha(s string) bool {
+	return s != "" && strings.Trim(s, "abcdefghijklmnopqrstuvwxyz") == ""
+}
+
+func isDigits(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
+
+func isAlphanum(s string) bool {
+	return s != "" && strings.Trim(s, "abcdefghijklmnopqrstuvwxyz0123456789") == ""
+}
+
+// timeZone - make sure that validated value is IANA time zone name known to
+// time.LoadLocation, e.g. "Europe/Kyiv". Names in other case are resolved when
+// possible and "canonical" flag returns resolved name.
+func timeZone(args ...interface{}) Validation {
+	isCanonical := hasFlag("canonical", args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+		name, ok := resolveTimeZone(v)
+		if !ok {
+			return nil, errors.New("NOT_TIME_ZONE")
+		}
+
+		if isCanonical {
+			return name, nil
+		}
+		return v, nil
+	}
+}
+
+// resolveTimeZone - find loadable zone name among the name itself, its title
+// case and upper case forms.
+func resolveTimeZone(name string) (string, bool) {
+	if name == "Local" {
+		return "", false
+	}
+
+	candidates := []string{name, titleZone(name), strings.ToUpper(name)}
+	for _, candidate := range candidates {
+		if _, err := time.LoadLocation(candidate); err == nil && candidate != "" && candidate != "Local" {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// titleZone - convert "america/new_york" to "America/New_York".
+func titleZone(name string) string {
+	b := []byte(strings.ToLower(name))
+	for i := range b {
+		if i == 0 || b[i-1] == '/' || b[i-1] == '_' || b[i-1] == '-' {
+			b[i] = strings.ToUpper(string(b[i]))[0]
+		}
+	}
+	return string(b)
+}
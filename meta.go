@@ -2,7 +2,10 @@ package livr
 
 import (
 	"errors"
+	"log"
 	"reflect"
+	"sort"
+	"strings"
 )
 
 // nestedObject - check that validated value is object.
@@ -57,8 +60,8 @@ func listOf(args ...interface{}) Validation {
 	}
 
 	validator := New(&Options{LivrRules: Dictionary{"field": lr}})
-	validator.prepare()
 	validator.registerRules(rB.(map[string]Builder))
+	validator.prepare()
 	return func(values interface{}, builders ...interface{}) (interface{}, interface{}) {
 		if values == nil || values == "" {
 			return nil, nil
@@ -113,8 +116,8 @@ func listOfObjects(args ...interface{}) Validation {
 	}
 
 	validator := New(&Options{LivrRules: lr})
-	validator.prepare()
 	validator.registerRules(rB)
+	validator.prepare()
 	return func(objects interface{}, builders ...interface{}) (interface{}, interface{}) {
 		if objects == nil || objects == "" {
 			return objects, nil
@@ -158,73 +161,33 @@ func listOfObjects(args ...interface{}) Validation {
 	}
 }
 
-// listOfDifferentObjects - checks that validated value is one of specified objects.
+// listOfDifferentObjects - checks that validated value is list of objects,
+// each checked by rules selected by selector field, see variableObject.
 func listOfDifferentObjects(args ...interface{}) Validation {
-	var validators = make(map[string]*Validator)
+	selector := newObjectSelector(args...)
 
-	var selField string
-	var lrs Dictionary
-
-	var rB map[string]Builder
-	if len(args) > 2 {
-		if v, ok := args[0].(string); ok {
-			selField = v
-		}
-		if v, ok := args[1].(Dictionary); ok {
-			lrs = v
-		}
-		if v, ok := args[2].(map[string]Builder); ok {
-			rB = v
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
 		}
-	}
 
-	for selVal, lr := range lrs {
-		rules, ok := lr.(Dictionary)
+		objects, ok := value.([]interface{})
 		if !ok {
-			continue
-		}
-		validator := New(&Options{LivrRules: rules})
-		validator.prepare()
-		validator.registerRules(rB)
-		validators[selVal] = validator
-	}
-
-	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
-		var objects []interface{}
-		if len(builders) > 0 {
-			if v, ok := value.([]interface{}); ok {
-				objects = v
-			}
+			return nil, errors.New("FORMAT_ERROR")
 		}
 
 		var results, errs []interface{}
 		var hasError bool
 		for _, object := range objects {
-			if _, ok := object.(Dictionary); !ok {
-				errs = append(errs, errors.New("FORMAT_ERROR"))
-				continue
-			}
-			if _, ok := object.(Dictionary)[selField]; !ok {
-				errs = append(errs, errors.New("FORMAT_ERROR"))
-				continue
-			}
-			// TODO: check it.
-			if v, ok := validators[object.(Dictionary)[selField].(string)]; !ok || v == nil {
-				errs = append(errs, errors.New("FORMAT_ERROR"))
-				continue
-			}
-
-			v := validators[object.(Dictionary)[selField].(string)]
-			r, err := v.Validate(object.(Dictionary))
-
-			if err == nil {
-				results = append(results, r)
-				errs = append(errs, nil)
-			} else {
-				results = append(results, nil)
-				errs = append(errs, v.errs)
+			r, err := selector.validate(object)
+			if err != nil {
 				hasError = true
+				results = append(results, nil)
+				errs = append(errs, err)
+				continue
 			}
+			results = append(results, r)
+			errs = append(errs, nil)
 		}
 
 		if hasError {
@@ -235,36 +198,91 @@ func listOfDifferentObjects(args ...interface{}) Validation {
 	}
 }
 
-// or - check that validated value is one of specified.
-func or(args ...interface{}) Validation {
-	fa := firstArg(args...)
+// NoMatchError - error of "or" rule that keeps errors of every alternative.
+type NoMatchError struct {
+	Errors []interface{}
+}
+
+func (e *NoMatchError) Error() string {
+	return "NO_MATCH"
+}
+
+// orOptions - names of "or" options, passed as the last argument.
+var orOptions = map[string]bool{"errors": true}
 
+// or - check that validated value is one of specified. The last argument can
+// be object with "errors" option that sets which error is returned when
+// value matches no alternative: "last" (default) - error of the last
+// alternative, "all" - NoMatchError with errors of every alternative,
+// "best" - error of alternative that got furthest.
+func or(args ...interface{}) Validation {
 	var lrs []interface{}
 	var rB map[string]Builder
-	if v, ok := fa.([]interface{}); ok {
-		lrs = v
-		if v, ok := args[2].(map[string]Builder); ok {
+	mode := "last"
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case map[string]Builder:
 			rB = v
+		case Dictionary:
+			if i == len(args)-2 && isOptions(v, orOptions) {
+				if m, ok := v["errors"].(string); ok {
+					mode = m
+				}
+				continue
+			}
+			lrs = append(lrs, v)
+		default:
+			lrs = append(lrs, v)
 		}
-		if len(args) > 1 {
-			if v, ok := args[1].(map[string]Builder); ok {
-				rB = v
+	}
+
+	var validators []*Validator
+	for _, lr := range lrs {
+		validators = append(validators, newChainValidator(lr, rB))
+	}
+
+	return func(val interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if val == nil || val == "" {
+			return val, nil
+		}
+
+		errs := make([]interface{}, 0, len(validators))
+		var bestErr interface{}
+		var bestScore []int
+		for _, validator := range validators {
+			res, err, passed := applyChain(validator, val, builders...)
+			if err == nil {
+				return res, nil
+			}
+
+			errs = append(errs, err)
+			if score := []int{passed, errorDepth(err), -errorCount(err)}; bestErr == nil || betterScore(score, bestScore) {
+				bestErr, bestScore = err, score
 			}
 		}
-	} else {
-		lrs = args[0 : len(args)-1]
-		if v, ok := args[len(args)-1].(map[string]Builder); ok {
-			rB = v
+
+		if len(errs) == 0 {
+			return nil, nil
+		}
+
+		switch mode {
+		case "all":
+			return nil, &NoMatchError{Errors: errs}
+		case "best":
+			return nil, bestErr
+		default:
+			return nil, errs[len(errs)-1]
 		}
 	}
+}
 
+// and - check that validated value passes all specified rules chains, output
+// of each chain is passed to the next one.
+func and(args ...interface{}) Validation {
 	var validators []*Validator
-
+	lrs, rB := chainArgs(args...)
 	for _, lr := range lrs {
-		validator := New(&Options{LivrRules: Dictionary{"field": lr}})
-		validator.prepare()
-		validator.registerRules(rB)
-		validators = append(validators, validator)
+		validators = append(validators, newChainValidator(lr, rB))
 	}
 
 	return func(val interface{}, builders ...interface{}) (interface{}, interface{}) {
@@ -272,35 +290,229 @@ func or(args ...interface{}) Validation {
 			return val, nil
 		}
 
-		var lastErr interface{}
 		for _, validator := range validators {
-			r, err := validator.Validate(Dictionary{"field": val})
+			res, err, _ := applyChain(validator, val, builders...)
+			if err != nil {
+				return nil, err
+			}
+			val = res
+		}
 
-			if err != nil && r == nil {
-				lastErr = validator.Errors()["field"]
-			} else {
-				return r["field"], nil
+		return val, nil
+	}
+}
+
+// notOptions - names of "not" options, passed as the last argument.
+var notOptions = map[string]bool{"error": true}
+
+// not - check that validated value does not pass specified rules, e.g.
+// {"not": [{"one_of": ["admin", "root"]}, {"error": "RESERVED"}]}. The last
+// argument can be object with "error" option to override "NOT_ALLOWED_VALUE"
+// error code.
+func not(args ...interface{}) Validation {
+	code := "NOT_ALLOWED_VALUE"
+	lrs, rB := chainArgs(args...)
+	if n := len(lrs); n > 1 {
+		if opts, ok := lrs[n-1].(Dictionary); ok && isOptions(opts, notOptions) {
+			if c, ok := opts["error"].(string); ok {
+				code = c
 			}
+			lrs = lrs[:n-1]
 		}
+	}
 
-		if lastErr != nil {
-			return nil, lastErr
+	var lr interface{} = lrs
+	if len(lrs) == 1 {
+		lr = lrs[0]
+	}
+	if chain, ok := lr.([]interface{}); ok && len(chain) == 0 {
+		log.Panicf("not: expected rules chain")
+	}
+	validator := newChainValidator(lr, rB)
+
+	return func(val interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if val == nil || val == "" {
+			return val, nil
 		}
 
-		return nil, nil
+		if _, err, _ := applyChain(validator, val, builders...); err == nil {
+			return nil, errors.New(code)
+		}
+
+		return val, nil
 	}
 }
 
+// _if - apply rules chain depending on whether validated value passes
+// condition rules chain, e.g. {"if": [condition, then, else]}.
+// Without "else" chain value that does not pass condition is left as is.
+func _if(args ...interface{}) Validation {
+	var cond, then, otherwise *Validator
+	lrs, rB := chainArgs(args...)
+	if len(lrs) > 0 {
+		cond = newChainValidator(lrs[0], rB)
+	}
+	if len(lrs) > 1 {
+		then = newChainValidator(lrs[1], rB)
+	}
+	if len(lrs) > 2 {
+		otherwise = newChainValidator(lrs[2], rB)
+	}
+
+	return func(val interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if val == nil || val == "" || cond == nil {
+			return val, nil
+		}
+
+		branch := then
+		if _, err, _ := applyChain(cond, val, builders...); err != nil {
+			branch = otherwise
+		}
+		if branch == nil {
+			return val, nil
+		}
+
+		res, err, _ := applyChain(branch, val, builders...)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+}
+
+// chainArgs - split rule args to rules chains and rule builders.
+func chainArgs(args ...interface{}) ([]interface{}, map[string]Builder) {
+	var lrs []interface{}
+	var rB map[string]Builder
+	for _, arg := range args {
+		if v, ok := arg.(map[string]Builder); ok {
+			rB = v
+			continue
+		}
+		lrs = append(lrs, arg)
+	}
+	return lrs, rB
+}
+
+// newChainValidator - build validator for rules chain applied to single value.
+func newChainValidator(lr interface{}, rB map[string]Builder) *Validator {
+	validator := New(&Options{LivrRules: Dictionary{"field": lr}})
+	validator.registerRules(rB)
+	validator.prepare()
+	return validator
+}
+
+// applyChain - apply rules chain validator to value, return result, error
+// and number of passed rules. Rules of chain get data and validated results of
+// the object the value belongs to, so cross-field rules work inside chains.
+func applyChain(validator *Validator, value interface{}, builders ...interface{}) (interface{}, interface{}, int) {
+	validators := validator.validators["field"]
+	if value == nil && validator.nullable["field"] {
+		return nil, nil, len(validators)
+	}
+	if len(builders) == 0 {
+		builders = []interface{}{Dictionary{"field": value}, make(Dictionary)}
+	}
+
+	for i, rule := range validators {
+		res, err := rule(value, builders...)
+		if err != nil {
+			return nil, err, i
+		}
+		if res != nil {
+			value = res
+		}
+	}
+
+	return value, nil, len(validators)
+}
+
+// errorDepth - return how deep into value the validation got, e.g. error of
+// nested object field is deeper than error of the object itself.
+func errorDepth(err interface{}) int {
+	depth := 0
+	switch e := err.(type) {
+	case Dictionary:
+		for _, fErr := range e {
+			if d := errorDepth(fErr) + 1; d > depth {
+				depth = d
+			}
+		}
+	case []interface{}:
+		for _, iErr := range e {
+			if d := errorDepth(iErr) + 1; d > depth {
+				depth = d
+			}
+		}
+	}
+	return depth
+}
+
+// errorCount - return number of errors in errors tree.
+func errorCount(err interface{}) int {
+	switch e := err.(type) {
+	case nil:
+		return 0
+	case Dictionary:
+		count := 0
+		for _, fErr := range e {
+			count += errorCount(fErr)
+		}
+		return count
+	case []interface{}:
+		count := 0
+		for _, iErr := range e {
+			count += errorCount(iErr)
+		}
+		return count
+	default:
+		return 1
+	}
+}
+
+// betterScore - compare scores element by element, the first greater wins.
+func betterScore(a, b []int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] > b[i]
+		}
+	}
+	return false
+}
+
 // variableObject - check that validated value is one of specified depends on some inner value.
+// Selector field can be dotted path, e.g. "meta.kind", and its value of any
+// scalar type is matched by string form. Rules under "$default" key are used
+// for unknown or missing selector, otherwise error is reported on selector field.
 func variableObject(args ...interface{}) Validation {
-	var validators = make(map[string]*Validator)
+	selector := newObjectSelector(args...)
+
+	return func(object interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if object == nil || object == "" {
+			return object, nil
+		}
+
+		return selector.validate(object)
+	}
+}
+
+// defaultSelectorKey - rules key for objects with unknown selector value.
+const defaultSelectorKey = "$default"
+
+// objectSelector - select object rules by value of selector field.
+type objectSelector struct {
+	field      string
+	validators map[string]*Validator
+}
+
+func newObjectSelector(args ...interface{}) *objectSelector {
+	s := &objectSelector{validators: make(map[string]*Validator)}
 
-	var selField string
 	var lrs Dictionary
 	var rB map[string]Builder
 	if len(args) > 2 {
 		if v, ok := args[0].(string); ok {
-			selField = v
+			s.field = v
 		}
 		if v, ok := args[1].(Dictionary); ok {
 			lrs = v
@@ -316,29 +528,268 @@ func variableObject(args ...interface{}) Validation {
 			continue
 		}
 		validator := New(&Options{LivrRules: rules})
-		validator.prepare()
 		validator.registerRules(rB)
-		validators[selVal] = validator
+		validator.prepare()
+		s.validators[selVal] = validator
 	}
 
-	return func(object interface{}, builders ...interface{}) (interface{}, interface{}) {
-		if _, ok := object.(Dictionary); !ok {
-			return nil, errors.New("FORMAT_ERROR")
+	return s
+}
+
+func (s *objectSelector) validate(value interface{}) (interface{}, interface{}) {
+	object, ok := value.(Dictionary)
+	if !ok {
+		return nil, errors.New("FORMAT_ERROR")
+	}
+
+	validator, err := s.selectValidator(object)
+	if err != nil {
+		return nil, err
+	}
+
+	r, vErr := validator.Validate(object)
+	if vErr != nil {
+		return nil, validator.errs
+	}
+	return r, nil
+}
+
+func (s *objectSelector) selectValidator(object Dictionary) (*Validator, interface{}) {
+	defaultValidator := s.validators[defaultSelectorKey]
+
+	selVal, ok := lookupField(object, s.field)
+	if !ok || isEmpty(selVal) {
+		if defaultValidator != nil {
+			return defaultValidator, nil
+		}
+		return nil, fieldError(s.field, "REQUIRED")
+	}
+
+	key, ok := scalarKey(selVal)
+	if !ok {
+		return nil, fieldError(s.field, "FORMAT_ERROR")
+	}
+
+	if validator, ok := s.validators[key]; ok && key != defaultSelectorKey {
+		return validator, nil
+	}
+	if defaultValidator != nil {
+		return defaultValidator, nil
+	}
+	return nil, fieldError(s.field, "UNKNOWN_TYPE")
+}
+
+// fieldError - make error for field, dotted path makes nested errors, e.g.
+// "meta.kind" gives {"meta": {"kind": code}}.
+func fieldError(path, code string) Dictionary {
+	keys := strings.Split(path, ".")
+	var err interface{} = errors.New(code)
+	for i := len(keys) - 1; i > 0; i-- {
+		err = Dictionary{keys[i]: err}
+	}
+	return Dictionary{keys[0]: err}
+}
+
+// mapOf - check that validated value is object with arbitrary keys, every key
+// and value is checked by own rules, e.g. {"map_of": ["string", "positive_decimal"]}.
+// Optional third argument limits number of keys.
+func mapOf(args ...interface{}) Validation {
+	var keyRules, valueRules interface{}
+	var maxKeys = -1.0
+	var rB map[string]Builder
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case map[string]Builder:
+			rB = v
+		case float64:
+			if i == 2 {
+				maxKeys = v
+			}
+		default:
+			switch i {
+			case 0:
+				keyRules = v
+			case 1:
+				valueRules = v
+			}
+		}
+	}
+
+	lr := make(Dictionary)
+	if keyRules != nil {
+		lr["key"] = keyRules
+	}
+	if valueRules != nil {
+		lr["value"] = valueRules
+	}
+
+	validator := New(&Options{LivrRules: lr})
+	validator.registerRules(rB)
+	validator.prepare()
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
 		}
-		if _, ok := object.(Dictionary)[selField]; !ok {
+
+		object, ok := value.(Dictionary)
+		if !ok {
 			return nil, errors.New("FORMAT_ERROR")
 		}
-		// TODO: check it.
-		if v, ok := validators[object.(Dictionary)[selField].(string)]; !ok || v == nil {
+		if maxKeys >= 0 && float64(len(object)) > maxKeys {
+			return nil, errors.New("TOO_MANY_KEYS")
+		}
+
+		// Keys are checked in sorted order, so the same key of normalized
+		// duplicates gets the error every time.
+		keys := make([]string, 0, len(object))
+		for key := range object {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		results := make(Dictionary, len(object))
+		errs := make(Dictionary)
+		for _, key := range keys {
+			val := object[key]
+			r, err := validator.Validate(Dictionary{"key": key, "value": val})
+			if err != nil {
+				if keyErr, ok := validator.errs["key"]; ok {
+					errs[key] = keyErr
+				} else {
+					errs[key] = validator.errs["value"]
+				}
+				continue
+			}
+
+			outKey := key
+			if k, ok := r["key"].(string); ok {
+				outKey = k
+			}
+			if _, ok := results[outKey]; ok {
+				errs[key] = errors.New("DUPLICATE_KEY")
+				continue
+			}
+			results[outKey] = val
+			if _, ok := lr["value"]; ok {
+				results[outKey] = r["value"]
+			}
+		}
+
+		if len(errs) > 0 {
+			return nil, errs
+		}
+		return results, nil
+	}
+}
+
+// tupleOptions - names of tuple options, passed as the last argument.
+var tupleOptions = map[string]bool{"additional_items": true, "min_items": true, "max_items": true}
+
+// tuple - check that validated value is list with own rules for every
+// position, e.g. {"tuple": ["decimal", "decimal"]}. The last argument can be
+// object with options: "additional_items" (rules for items after the last
+// position or true to accept any), "min_items" and "max_items". By default
+// list must have exactly as many items as positions.
+func tuple(args ...interface{}) Validation {
+	var chains []interface{}
+	var opts Dictionary
+	var rB map[string]Builder
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case map[string]Builder:
+			rB = v
+		case Dictionary:
+			if i == len(args)-2 && isOptions(v, tupleOptions) {
+				opts = v
+				continue
+			}
+			chains = append(chains, v)
+		default:
+			chains = append(chains, v)
+		}
+	}
+
+	var validators []*Validator
+	for _, lr := range chains {
+		validators = append(validators, newChainValidator(lr, rB))
+	}
+
+	minItems, maxItems := float64(len(chains)), float64(len(chains))
+	var additional *Validator
+	switch v := opts["additional_items"].(type) {
+	case nil:
+	case bool:
+		if v {
+			maxItems = -1
+		}
+	default:
+		additional = newChainValidator(v, rB)
+		maxItems = -1
+	}
+	if v, ok := opts["min_items"].(float64); ok {
+		minItems = v
+	}
+	if v, ok := opts["max_items"].(float64); ok {
+		maxItems = v
+	}
+
+	return func(values interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if values == nil || values == "" {
+			return values, nil
+		}
+
+		items, ok := values.([]interface{})
+		if !ok {
 			return nil, errors.New("FORMAT_ERROR")
 		}
+		if float64(len(items)) < minItems {
+			return nil, errors.New("TOO_FEW_ITEMS")
+		}
+		if maxItems >= 0 && float64(len(items)) > maxItems {
+			return nil, errors.New("TOO_MANY_ITEMS")
+		}
 
-		v := validators[object.(Dictionary)[selField].(string)]
+		var results, errs []interface{}
+		var hasError bool
+		for i, item := range items {
+			validator := additional
+			if i < len(validators) {
+				validator = validators[i]
+			}
+			if validator == nil {
+				results = append(results, item)
+				errs = append(errs, nil)
+				continue
+			}
 
-		r, err := v.Validate(object.(Dictionary))
-		if err != nil {
-			return nil, v.errs
+			r, err := validator.Validate(Dictionary{"field": item})
+			if err != nil {
+				hasError = true
+				errs = append(errs, validator.errs["field"])
+				results = append(results, nil)
+				continue
+			}
+			results = append(results, r["field"])
+			errs = append(errs, nil)
+		}
+
+		if hasError {
+			return nil, errs
+		}
+		return results, nil
+	}
+}
+
+// isOptions - check that all keys of object are known options.
+func isOptions(object Dictionary, options map[string]bool) bool {
+	if len(object) == 0 {
+		return false
+	}
+	for key := range object {
+		if !options[key] {
+			return false
 		}
-		return r, nil
 	}
+	return true
 }
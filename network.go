@@ -0,0 +1,174 @@
+package livr
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ipv4 - make sure that validated value is valid IPv4 address.
+// With "canonical" flag outputs address in canonical form.
+func ipv4(args ...interface{}) Validation {
+	return ipRule(func(a netip.Addr) bool { return a.Is4() }, hasFlag("canonical", args...))
+}
+
+// ipv6 - make sure that validated value is valid IPv6 address.
+// With "canonical" flag outputs address in canonical (compressed, lower case) form.
+func ipv6(args ...interface{}) Validation {
+	return ipRule(func(a netip.Addr) bool { return a.Is6() }, hasFlag("canonical", args...))
+}
+
+// ip - make sure that validated value is valid IPv4 or IPv6 address.
+// With "canonical" flag outputs address in canonical form.
+func ip(args ...interface{}) Validation {
+	return ipRule(func(a netip.Addr) bool { return true }, hasFlag("canonical", args...))
+}
+
+func ipRule(isFamily func(netip.Addr) bool, canonical bool) Validation {
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		addr, err := netip.ParseAddr(v)
+		if err != nil || addr.Zone() != "" || !isFamily(addr) {
+			return nil, errors.New("NOT_IP")
+		}
+
+		if canonical {
+			return addr.String(), nil
+		}
+		return value, nil
+	}
+}
+
+// cidr - make sure that validated value is valid network in CIDR notation.
+// Family can be restricted with "ipv4" or "ipv6" flag, with "canonical" flag
+// outputs network with host bits masked, e.g. "10.1.2.3/8" as "10.0.0.0/8".
+func cidr(args ...interface{}) Validation {
+	only4, only6 := hasFlag("ipv4", args...), hasFlag("ipv6", args...)
+	canonical := hasFlag("canonical", args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			return nil, errors.New("NOT_CIDR")
+		}
+		if (only4 && !prefix.Addr().Is4()) || (only6 && !prefix.Addr().Is6()) {
+			return nil, errors.New("WRONG_IP_FAMILY")
+		}
+
+		if canonical {
+			return prefix.Masked().String(), nil
+		}
+		return value, nil
+	}
+}
+
+// macAddress - make sure that validated value is valid EUI-48 MAC address.
+// With "canonical" flag outputs address as lower case colon separated octets.
+func macAddress(args ...interface{}) Validation {
+	canonical := hasFlag("canonical", args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		hw, err := net.ParseMAC(v)
+		if err != nil || len(hw) != 6 {
+			return nil, errors.New("NOT_MAC_ADDRESS")
+		}
+
+		if canonical {
+			return hw.String(), nil
+		}
+		return value, nil
+	}
+}
+
+var hostnameLabelRe = regexp.MustCompile(`^(?i)[a-z0-9](?:[a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// hostname - make sure that validated value is valid host name as described
+// in RFC 1123. With "canonical" flag outputs lower case name without
+// trailing dot.
+func hostname(args ...interface{}) Validation {
+	canonical := hasFlag("canonical", args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		name := strings.TrimSuffix(v, ".")
+		if name == "" || len(name) > 253 {
+			return nil, errors.New("NOT_HOSTNAME")
+		}
+		for _, label := range strings.Split(name, ".") {
+			if !hostnameLabelRe.MatchString(label) {
+				return nil, errors.New("NOT_HOSTNAME")
+			}
+		}
+
+		if canonical {
+			return strings.ToLower(name), nil
+		}
+		return value, nil
+	}
+}
+
+// port - make sure that validated value is valid TCP/UDP port number (1-65535).
+func port(args ...interface{}) Validation {
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		var p float64
+		switch v := value.(type) {
+		case float64:
+			p = v
+		case string:
+			n, err := strconv.ParseUint(v, 10, 16)
+			if err != nil {
+				return nil, errors.New("NOT_PORT")
+			}
+			p = float64(n)
+		default:
+			return nil, errors.New("FORMAT_ERROR")
+		}
+
+		if p != float64(int(p)) || p < 1 || p > 65535 {
+			return nil, errors.New("NOT_PORT")
+		}
+
+		return p, nil
+	}
+}
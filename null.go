@@ -0,0 +1,64 @@
+package livr
+
+type emptyString uint8
+
+const (
+	// EmptyStringValue - empty string is passed to rules as is, default mode.
+	EmptyStringValue emptyString = iota
+	// EmptyStringNull - empty string is treated as explicit null.
+	EmptyStringNull
+	// EmptyStringMissing - field with empty string is treated as missing.
+	EmptyStringMissing
+)
+
+// nullable - allow explicit null for field: null skips all field rules and
+// is kept in output, while missing field is still checked by them. The rule
+// itself passes value as is, validator handles it when applies rules.
+func nullable(args ...interface{}) Validation {
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		return value, nil
+	}
+}
+
+// hasNullable - check that rules chain contains nullable rule.
+func hasNullable(fieldRules []interface{}) bool {
+	for _, rawRule := range fieldRules {
+		if name, _ := parseRule(rawRule); name == "nullable" {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeEmpty - replace empty strings in objects according to mode, lists
+// items are replaced with null in both modes.
+func normalizeEmpty(data interface{}, mode emptyString) interface{} {
+	if mode == EmptyStringValue {
+		return data
+	}
+
+	switch d := data.(type) {
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(d))
+		for key, val := range d {
+			if val == "" && mode == EmptyStringMissing {
+				continue
+			}
+			normalized[key] = normalizeEmpty(val, mode)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(d))
+		for i, val := range d {
+			normalized[i] = normalizeEmpty(val, mode)
+		}
+		return normalized
+	case string:
+		if d == "" {
+			return nil
+		}
+		return d
+	default:
+		return d
+	}
+}
@@ -1,20 +1,38 @@
 package livr
 
 import (
+	"encoding/json"
 	"errors"
+	"math"
 	"strconv"
 )
 
+type integerOutput uint8
+
+const (
+	// IntegerFloat64 - integer rules return strings as float64 and keep type
+	// of numbers, default mode.
+	IntegerFloat64 integerOutput = iota
+	// IntegerInt64 - integer rules return int64.
+	IntegerInt64
+	// IntegerJSONNumber - integer rules return json.Number.
+	IntegerJSONNumber
+)
+
+// integerRules - rules that output depends on integerOutput mode.
+var integerRules = []string{"integer", "positive_integer"}
+
 // decimal - check that validated value is decimal number.
 func decimal(args ...interface{}) Validation {
 	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
 		if value == nil || value == "" {
 			return value, nil
 		}
+		if _, ok := numberValue(value); ok {
+			return value, nil
+		}
 
 		switch v := value.(type) {
-		case float64:
-			return v, nil
 		case string:
 			if f, err := strconv.ParseFloat(v, 64); err == nil {
 				return f, nil
@@ -33,18 +51,16 @@ func integer(args ...interface{}) Validation {
 		if value == nil || value == "" {
 			return value, nil
 		}
-
-		switch v := value.(type) {
-		case float64:
-			if v != float64(int(v)) {
+		if f, ok := numberValue(value); ok {
+			if !isWhole(f) {
 				return nil, errors.New("NOT_INTEGER")
 			}
-			return v, nil
+			return value, nil
+		}
+
+		switch v := value.(type) {
 		case string:
-			if f, err := strconv.ParseFloat(v, 64); err == nil {
-				if f != float64(int(f)) {
-					return nil, errors.New("NOT_INTEGER")
-				}
+			if f, ok := parseInteger(v); ok {
 				return f, nil
 			}
 			return nil, errors.New("NOT_INTEGER")
@@ -68,25 +84,15 @@ func maxNumber(args ...interface{}) Validation {
 			return value, nil
 		}
 
-		switch v := value.(type) {
-		case float64:
-			if v > maxNumber {
-				return nil, errors.New("TOO_HIGH")
-			}
-
-			return v, nil
-		case string:
-			if f, err := strconv.ParseFloat(v, 64); err == nil {
-				if f > maxNumber {
-					return nil, errors.New("TOO_HIGH")
-				}
-				return f, nil
-			}
-
-			return nil, errors.New("NOT_NUMBER")
-		default:
-			return nil, errors.New("FORMAT_ERROR")
+		f, res, err := parseNumber(value)
+		if err != nil {
+			return nil, err
+		}
+		if f > maxNumber {
+			return nil, errors.New("TOO_HIGH")
 		}
+
+		return res, nil
 	}
 }
 
@@ -104,25 +110,15 @@ func minNumber(args ...interface{}) Validation {
 			return value, nil
 		}
 
-		switch v := value.(type) {
-		case float64:
-			if v < minNumber {
-				return nil, errors.New("TOO_LOW")
-			}
-
-			return v, nil
-		case string:
-			if f, err := strconv.ParseFloat(v, 64); err == nil {
-				if f < minNumber {
-					return nil, errors.New("TOO_LOW")
-				}
-				return f, nil
-			}
-
-			return nil, errors.New("NOT_NUMBER")
-		default:
-			return nil, errors.New("FORMAT_ERROR")
+		f, res, err := parseNumber(value)
+		if err != nil {
+			return nil, err
 		}
+		if f < minNumber {
+			return nil, errors.New("TOO_LOW")
+		}
+
+		return res, nil
 	}
 }
 
@@ -143,31 +139,18 @@ func numberBetween(args ...interface{}) Validation {
 			return value, nil
 		}
 
-		switch v := value.(type) {
-		case float64:
-			if v > maxNumber {
-				return nil, errors.New("TOO_HIGH")
-			}
-			if v < minNumber {
-				return nil, errors.New("TOO_LOW")
-			}
-
-			return v, nil
-		case string:
-			if f, err := strconv.ParseFloat(v, 64); err == nil {
-				if f > maxNumber {
-					return nil, errors.New("TOO_HIGH")
-				}
-				if f < minNumber {
-					return nil, errors.New("TOO_LOW")
-				}
-				return f, nil
-			}
-
-			return nil, errors.New("NOT_NUMBER")
-		default:
-			return nil, errors.New("FORMAT_ERROR")
+		f, res, err := parseNumber(value)
+		if err != nil {
+			return nil, err
+		}
+		if f > maxNumber {
+			return nil, errors.New("TOO_HIGH")
 		}
+		if f < minNumber {
+			return nil, errors.New("TOO_LOW")
+		}
+
+		return res, nil
 	}
 }
 
@@ -177,18 +160,17 @@ func positiveInteger(args ...interface{}) Validation {
 		if value == nil || value == "" {
 			return value, nil
 		}
-
-		switch v := value.(type) {
-		case float64:
-			if v != float64(int(v)) || v <= 0 {
+		if f, ok := numberValue(value); ok {
+			if !isWhole(f) || f <= 0 {
 				return nil, errors.New("NOT_POSITIVE_INTEGER")
 			}
-			return v, nil
+			return value, nil
+		}
+
+		switch v := value.(type) {
 		case string:
-			if f, err := strconv.ParseFloat(v, 64); err == nil {
-				if f > 0 {
-					return f, nil
-				}
+			if f, ok := parseInteger(v); ok && f > 0 {
+				return f, nil
 			}
 			return nil, errors.New("NOT_POSITIVE_INTEGER")
 		default:
@@ -203,13 +185,14 @@ func positiveDecimal(args ...interface{}) Validation {
 		if value == nil || value == "" {
 			return value, nil
 		}
-
-		switch v := value.(type) {
-		case float64:
-			if v <= 0 {
+		if f, ok := numberValue(value); ok {
+			if f <= 0 {
 				return nil, errors.New("NOT_POSITIVE_DECIMAL")
 			}
-			return v, nil
+			return value, nil
+		}
+
+		switch v := value.(type) {
 		case string:
 			if f, err := strconv.ParseFloat(v, 64); err == nil {
 				if f > 0 {
@@ -223,3 +206,131 @@ func positiveDecimal(args ...interface{}) Validation {
 		}
 	}
 }
+
+// numberValue - return JSON number or number of Go numeric type as float64.
+func numberValue(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// parseNumber - return value as float64 for comparison along with output
+// value: numbers keep their type and strings are parsed to float64.
+func parseNumber(value interface{}) (float64, interface{}, error) {
+	if f, ok := numberValue(value); ok {
+		return f, value, nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f, f, nil
+		}
+		return 0, nil, errors.New("NOT_NUMBER")
+	default:
+		return 0, nil, errors.New("FORMAT_ERROR")
+	}
+}
+
+// parseInteger - parse string as whole finite number. Strings in int64 range
+// are parsed without float rounding, see integerBuilder.
+func parseInteger(s string) (float64, bool) {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return float64(i), true
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || !isWhole(f) {
+		return 0, false
+	}
+	return f, true
+}
+
+// isWhole - check that number is finite and has no fractional part.
+func isWhole(f float64) bool {
+	return !math.IsInf(f, 0) && !math.IsNaN(f) && f == math.Trunc(f)
+}
+
+// integerBuilder - wrap builder of integer rule to convert its output.
+// Integer strings are converted from the string itself, as float64 output of
+// the rule loses precision beyond 2^53.
+func integerBuilder(builder Builder, mode integerOutput) Builder {
+	return func(args ...interface{}) Validation {
+		validation := builder(args...)
+
+		return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+			res, err := validation(value, builders...)
+			if err != nil || res == nil || res == "" {
+				return res, err
+			}
+			if s, ok := value.(string); ok {
+				if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+					res = i
+				}
+			}
+			return convertInteger(res, mode), nil
+		}
+	}
+}
+
+// convertInteger - convert validated integer according to mode, integers out
+// of int64 range and numbers that are not whole are returned as is.
+func convertInteger(value interface{}, mode integerOutput) interface{} {
+	switch mode {
+	case IntegerInt64:
+		switch v := value.(type) {
+		case int64:
+			return v
+		case int:
+			return int64(v)
+		case json.Number:
+			if i, err := v.Int64(); err == nil {
+				return i
+			}
+		}
+		if f, ok := numberValue(value); ok && isWhole(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+			return int64(f)
+		}
+	case IntegerJSONNumber:
+		switch v := value.(type) {
+		case json.Number:
+			return v
+		case int64:
+			return json.Number(strconv.FormatInt(v, 10))
+		case int:
+			return json.Number(strconv.Itoa(v))
+		}
+		if f, ok := numberValue(value); ok && isWhole(f) {
+			return json.Number(strconv.FormatFloat(f, 'f', -1, 64))
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,85 @@
+package livr
+
+import "errors"
+
+// atLeastOneOf - object rule that checks that at least one of specified
+// fields is present.
+func atLeastOneOf(args ...interface{}) Validation {
+	fields := fieldArgs(args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		present, _ := presentFields(value, fields)
+		if len(present) == 0 {
+			return nil, fieldErrors(fields, "REQUIRED")
+		}
+		return value, nil
+	}
+}
+
+// exactlyOneOf - object rule that checks that exactly one of specified
+// fields is present.
+func exactlyOneOf(args ...interface{}) Validation {
+	fields := fieldArgs(args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		present, _ := presentFields(value, fields)
+		switch len(present) {
+		case 0:
+			return nil, fieldErrors(fields, "REQUIRED")
+		case 1:
+			return value, nil
+		default:
+			return nil, fieldErrors(present, "MUTUALLY_EXCLUSIVE")
+		}
+	}
+}
+
+// mutuallyExclusive - object rule that checks that no more than one of
+// specified fields is present.
+func mutuallyExclusive(args ...interface{}) Validation {
+	fields := fieldArgs(args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		present, _ := presentFields(value, fields)
+		if len(present) > 1 {
+			return nil, fieldErrors(present, "MUTUALLY_EXCLUSIVE")
+		}
+		return value, nil
+	}
+}
+
+// allOrNone - object rule that checks that either all specified fields are
+// present or none of them.
+func allOrNone(args ...interface{}) Validation {
+	fields := fieldArgs(args...)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		present, missing := presentFields(value, fields)
+		if len(present) > 0 && len(missing) > 0 {
+			return nil, fieldErrors(missing, "REQUIRED")
+		}
+		return value, nil
+	}
+}
+
+// presentFields - split fields to present and missing in object.
+func presentFields(object interface{}, fields []string) (present, missing []string) {
+	data, _ := object.(Dictionary)
+	for _, field := range fields {
+		if v, ok := lookupField(data, field); ok && !isEmpty(v) {
+			present = append(present, field)
+		} else {
+			missing = append(missing, field)
+		}
+	}
+	return present, missing
+}
+
+// fieldErrors - make the same error for every field.
+func fieldErrors(fields []string, code string) Dictionary {
+	errs := make(Dictionary, len(fields))
+	for _, field := range fields {
+		errs[field] = errors.New(code)
+	}
+	return errs
+}
@@ -0,0 +1,275 @@
+package livr
+
+import (
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// phoneRegion - numbering plan of region: country calling code, length range
+// of national significant number and national trunk prefix.
+type phoneRegion struct {
+	code     string
+	min, max int
+	trunk    string
+}
+
+// phoneRegions - numbering plans by ISO 3166-1 region code, "-" stands for
+// region without trunk prefix. Regions sharing calling code (e.g. +1 of North
+// American Numbering Plan) are told apart by phonePrefixes.
+var phoneRegions = func() map[string]phoneRegion {
+	table := `
+		US 1 10 10 1   CA 1 10 10 1   AG 1 10 10 1   AI 1 10 10 1   AS 1 10 10 1   BB 1 10 10 1
+		BM 1 10 10 1   BS 1 10 10 1   DM 1 10 10 1   DO 1 10 10 1   GD 1 10 10 1   GU 1 10 10 1
+		JM 1 10 10 1   KN 1 10 10 1   KY 1 10 10 1   LC 1 10 10 1   MP 1 10 10 1   MS 1 10 10 1
+		PR 1 10 10 1   SX 1 10 10 1   TC 1 10 10 1   TT 1 10 10 1   VC 1 10 10 1   VG 1 10 10 1
+		VI 1 10 10 1   RU 7 10 10 8   KZ 7 10 10 8   EG 20 8 10 0   ZA 27 9 9 0    GR 30 10 10 -
+		NL 31 9 9 0    BE 32 8 9 0    FR 33 9 9 0    ES 34 9 9 -    HU 36 8 9 06   IT 39 6 11 -
+		VA 39 6 11 -   RO 40 9 9 0    CH 41 9 9 0    AT 43 4 13 0   GB 44 9 10 0   GG 44 10 10 0
+		JE 44 10 10 0  IM 44 10 10 0  DK 45 8 8 -    SE 46 7 10 0   NO 47 8 8 -    SJ 47 8 8 -
+		PL 48 9 9 -    DE 49 5 13 0   PE 51 8 9 0    MX 52 10 10 -  CU 53 6 8 0    AR 54 10 11 0
+		BR 55 10 11 0  CL 56 9 9 -    CO 57 10 10 -  VE 58 10 10 0  MY 60 8 10 0   AU 61 9 9 0
+		CX 61 9 9 0    CC 61 9 9 0    ID 62 8 12 0   PH 63 8 10 0   NZ 64 8 10 0   SG 65 8 8 -
+		TH 66 8 9 0    JP 81 9 10 0   KR 82 8 10 0   VN 84 9 10 0   CN 86 9 11 0   TR 90 10 10 0
+		IN 91 10 10 0  PK 92 9 10 0   AF 93 9 9 0    LK 94 9 9 0    MM 95 7 10 0   IR 98 10 10 0
+		SS 211 9 9 0   MA 212 9 9 0   EH 212 9 9 0   DZ 213 8 9 0   TN 216 8 8 -   LY 218 8 9 0
+		GM 220 7 7 -   SN 221 9 9 -   MR 222 8 8 -   ML 223 8 8 -   GN 224 8 9 -   CI 225 10 10 -
+		BF 226 8 8 -   NE 227 8 8 -   TG 228 8 8 -   BJ 229 8 10 -  MU 230 7 8 -   LR 231 7 9 0
+		SL 232 8 8 0   GH 233 9 9 0   NG 234 8 10 0  TD 235 8 8 -   CF 236 8 8 -   CM 237 9 9 -
+		CV 238 7 7 -   ST 239 7 7 -   GQ 240 9 9 -   GA 241 7 8 0   CG 242 9 9 -   CD 243 9 9 0
+		AO 244 9 9 -   GW 245 7 9 -   IO 246 7 7 -   SC 248 7 7 -   SD 249 9 9 0   RW 250 9 9 0
+		ET 251 9 9 0   SO 252 7 9 0   DJ 253 8 8 -   KE 254 9 10 0  TZ 255 9 9 0   UG 256 9 9 0
+		BI 257 8 8 -   MZ 258 8 9 -   ZM 260 9 9 0   MG 261 9 9 0   RE 262 9 9 0   YT 262 9 9 0
+		ZW 263 8 10 0  NA 264 8 9 0   MW 265 7 9 0   LS 266 8 8 -   BW 267 7 8 -   SZ 268 8 8 -
+		KM 269 7 7 -   SH 290 4 5 -   ER 291 7 7 0   AW 297 7 7 -   FO 298 6 6 -   GL 299 6 6 -
+		GI 350 8 8 -   PT 351 9 9 -   LU 352 4 11 -  IE 353 7 9 0   IS 354 7 9 -   AL 355 8 9 0
+		MT 356 8 8 -   CY 357 8 8 -   FI 358 5 12 0  AX 358 5 12 0  BG 359 8 9 0   LT 370 8 8 8
+		LV 371 8 8 -   EE 372 7 8 -   MD 373 8 8 0   AM 374 8 8 0   BY 375 9 9 80  AD 376 6 9 -
+		MC 377 8 9 -   SM 378 6 10 -  UA 380 9 9 0   RS 381 8 9 0   ME 382 8 8 0   HR 385 8 9 0
+		SI 386 8 8 0   BA 387 8 8 0   MK 389 8 8 0   CZ 420 9 9 -   SK 421 9 9 0   LI 423 7 9 -
+		FK 500 5 5 -   BZ 501 7 7 -   GT 502 8 8 -   SV 503 8 8 -   HN 504 8 8 -   NI 505 8 8 -
+		CR 506 8 8 -   PA 507 7 8 -   PM 508 6 6 -   HT 509 8 8 -   GP 590 9 9 0   BL 590 9 9 0
+		MF 590 9 9 0   BO 591 8 8 0   GY 592 7 7 -   EC 593 8 9 0   GF 594 9 9 0   PY 595 6 9 0
+		MQ 596 9 9 0   SR 597 6 7 -   UY 598 8 8 0   CW 599 7 8 -   BQ 599 7 7 -   TL 670 7 8 -
+		NF 672 6 6 -   BN 673 7 7 -   NR 674 7 7 -   PG 675 7 8 -   TO 676 5 7 -   SB 677 5 7 -
+		VU 678 5 7 -   FJ 679 7 7 -   PW 680 7 7 -   WF 681 6 6 -   CK 682 5 5 -   NU 683 4 7 -
+		WS 685 5 10 -  KI 686 5 8 -   NC 687 6 6 -   TV 688 5 7 -   PF 689 8 8 -   TK 690 4 7 -
+		FM 691 7 7 -   MH 692 7 7 -   KP 850 8 10 0  HK 852 8 8 -   MO 853 8 8 -   KH 855 8 9 0
+		LA 856 8 10 0  BD 880 8 10 0  TW 886 8 9 0   MV 960 7 7 -   LB 961 7 8 0   JO 962 8 9 0
+		SY 963 8 9 0   IQ 964 8 10 0  KW 965 8 8 -   SA 966 9 9 0   YE 967 7 9 0   OM 968 8 8 -
+		PS 970 8 9 0   AE 971 8 9 0   IL 972 8 9 0   BH 973 8 8 -   QA 974 7 8 -   BT 975 7 8 -
+		MN 976 8 8 0   NP 977 8 10 0  TJ 992 9 9 -   TM 993 8 8 8   AZ 994 9 9 0   GE 995 9 9 0
+		KG 996 9 9 0   UZ 998 9 9 -`
+
+	regions := make(map[string]phoneRegion)
+	fields := strings.Fields(table)
+	for i := 0; i+4 < len(fields); i += 5 {
+		min, _ := strconv.Atoi(fields[i+2])
+		max, _ := strconv.Atoi(fields[i+3])
+		trunk := fields[i+4]
+		if trunk == "-" {
+			trunk = ""
+		}
+		regions[fields[i]] = phoneRegion{code: fields[i+1], min: min, max: max, trunk: trunk}
+	}
+	return regions
+}()
+
+// phonePrefixes - leading digits (area codes) of national numbers of regions
+// that share calling code, the region of the code without prefixes gets all
+// other numbers, e.g. +1 numbers out of listed area codes are US ones.
+var phonePrefixes = map[string][]string{
+	"CA": strings.Fields(`204 226 236 249 250 263 289 306 343 354 365 367 368 382 403 416 418 428 431 437
+		438 450 460 468 474 506 514 519 548 579 581 584 587 604 613 639 647 672 683 709 742 753 778 780 782
+		807 819 825 867 873 879 902 905`),
+	"AG": {"268"}, "AI": {"264"}, "AS": {"684"}, "BB": {"246"}, "BM": {"441"}, "BS": {"242"},
+	"DM": {"767"}, "DO": {"809", "829", "849"}, "GD": {"473"}, "GU": {"671"}, "JM": {"658", "876"},
+	"KN": {"869"}, "KY": {"345"}, "LC": {"758"}, "MP": {"670"}, "MS": {"664"}, "PR": {"787", "939"},
+	"SX": {"721"}, "TC": {"649"}, "TT": {"868"}, "VC": {"784"}, "VG": {"284"}, "VI": {"340"},
+
+	"KZ": {"6", "7"},
+	"VA": {"06698"},
+	"GG": {"1481", "7781", "7839", "7911"},
+	"JE": {"1534", "7509", "7700", "7797", "7829", "7937"},
+	"IM": {"1624", "74576", "7524", "7624", "7924"},
+	"SJ": {"79"},
+	"CX": {"89164"},
+	"CC": {"89162"},
+	"EH": {"5288", "5289"},
+	"YT": {"269", "639"},
+	"AX": {"18"},
+	"BL": {"59027"},
+	"MF": {"59087"},
+	"BQ": {"3", "4", "7"},
+}
+
+// phoneRegionMatches - check that national number belongs to region with
+// calling code, see phonePrefixes. prefixed tells that number matched
+// prefixes of other region with the same code.
+func phoneRegionMatches(name, number string, prefixed bool) bool {
+	prefixes, ok := phonePrefixes[name]
+	if !ok {
+		return !prefixed
+	}
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(number, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// phoneOptions - names of "phone" options.
+var phoneOptions = map[string]bool{"region": true, "regions": true}
+
+// phone - make sure that validated value is phone number and convert it to
+// E.164 format, e.g. "+1 (202) 555-0193" becomes "+12025550193". Numbers can
+// contain spaces, dashes, dots, slashes and parentheses, international ones
+// start with "+" or "00". National numbers need default region:
+// {"phone": "UA"} or {"phone": {"region": "UA", "regions": ["UA", "PL"]}},
+// where "regions" restricts regions of numbers. Region codes are case
+// insensitive.
+func phone(args ...interface{}) Validation {
+	opts := Dictionary{}
+	switch v := firstArg(args...).(type) {
+	case string:
+		opts["region"] = v
+	case Dictionary:
+		if len(v) > 0 && !isOptions(v, phoneOptions) {
+			log.Panicf("phone: unknown options in %v", v)
+		}
+		opts = v
+	case nil, map[string]Builder:
+	default:
+		log.Panicf("phone: invalid options %v", v)
+	}
+
+	region, _ := opts["region"].(string)
+	region = strings.ToUpper(region)
+	if _, ok := opts["region"]; ok && phoneRegions[region].code == "" {
+		log.Panicf("phone: unknown region %v", opts["region"])
+	}
+	var allowed map[string]bool
+	if regions, ok := opts["regions"]; ok {
+		list, _ := regions.([]interface{})
+		if len(list) == 0 {
+			log.Panicf("phone: expected non-empty list of regions, got %v", regions)
+		}
+		allowed = make(map[string]bool, len(list))
+		for _, r := range list {
+			s, _ := r.(string)
+			s = strings.ToUpper(s)
+			if phoneRegions[s].code == "" {
+				log.Panicf("phone: unknown region %v", r)
+			}
+			allowed[s] = true
+		}
+	}
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+		code, number, err := parsePhone(v, region)
+		if err != nil {
+			return nil, err
+		}
+
+		var prefixed bool
+		for name, r := range phoneRegions {
+			if r.code == code && len(phonePrefixes[name]) > 0 && phoneRegionMatches(name, number, false) {
+				prefixed = true
+				break
+			}
+		}
+
+		var tooShort, restricted bool
+		for name, r := range phoneRegions {
+			switch {
+			case r.code != code || !phoneRegionMatches(name, number, prefixed):
+			case len(number) < r.min:
+				tooShort = true
+			case len(number) > r.max:
+			case allowed != nil && !allowed[name]:
+				restricted = true
+			default:
+				return "+" + code + number, nil
+			}
+		}
+
+		switch {
+		case restricted:
+			return nil, errors.New("NOT_ALLOWED_REGION")
+		case tooShort:
+			return nil, errors.New("TOO_SHORT")
+		default:
+			return nil, errors.New("TOO_LONG")
+		}
+	}
+}
+
+// parsePhone - split phone number to country calling code and national
+// significant number.
+func parsePhone(value, region string) (string, string, interface{}) {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "+") || strings.HasPrefix(value, "00") {
+		// Trunk prefix kept in international form, e.g. "+44 (0) 20 7946 0958".
+		value = strings.Replace(value, "(0)", "", 1)
+	}
+
+	var digits strings.Builder
+	international := false
+	for i, c := range value {
+		switch {
+		case c >= '0' && c <= '9':
+			digits.WriteRune(c)
+		case c == '+' && i == 0:
+			international = true
+		case strings.ContainsRune(" -./()", c):
+		default:
+			return "", "", errors.New("NOT_PHONE")
+		}
+	}
+
+	number := digits.String()
+	if !international && strings.HasPrefix(number, "00") {
+		international = true
+		number = number[2:]
+	}
+	if number == "" || len(number) > 15 {
+		return "", "", errors.New("NOT_PHONE")
+	}
+
+	if international {
+		for n := 1; n <= 3 && n < len(number); n++ {
+			if phoneCallingCode(number[:n]) {
+				return number[:n], number[n:], nil
+			}
+		}
+		return "", "", errors.New("UNKNOWN_CALLING_CODE")
+	}
+
+	r, ok := phoneRegions[region]
+	if !ok {
+		return "", "", errors.New("NOT_PHONE")
+	}
+	if r.trunk != "" && len(number) > r.min {
+		number = strings.TrimPrefix(number, r.trunk)
+	}
+	return r.code, number, nil
+}
+
+func phoneCallingCode(code string) bool {
+	for _, r := range phoneRegions {
+		if r.code == code {
+			return true
+		}
+	}
+	return false
+}
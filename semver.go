@@ -0,0 +1,236 @@
+package livr
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// semverRe - semantic version as described in https://semver.org.
+var semverRe = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+var semverComparatorRe = regexp.MustCompile(`^(>=|<=|>|<|=|~|\^)?(.+)$`)
+
+type semver struct {
+	major, minor, patch uint64
+	pre                 []string
+}
+
+// semverRange - alternatives separated by "||", every one is a set of
+// comparators that all must match.
+type semverRange [][]semverComparator
+
+type semverComparator struct {
+	op      string
+	version semver
+}
+
+// semVer - make sure that validated value is semantic version, optional
+// argument is range of allowed versions, e.g. {"semver": ">=1.2.0 <2.0.0"}.
+// Range supports =, >, >=, <, <=, ~ (same minor), ^ (same major) operators
+// and alternatives separated by "||".
+func semVer(args ...interface{}) Validation {
+	var constraint semverRange
+	if s, ok := firstArg(args...).(string); ok {
+		r, err := parseSemverRange(s)
+		if err != nil {
+			log.Panicf("semver: %v", err)
+		}
+		constraint = r
+	}
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		v, ok := value.(string)
+		if !ok {
+			return nil, errors.New("FORMAT_ERROR")
+		}
+		version, ok := parseSemver(v)
+		if !ok {
+			return nil, errors.New("NOT_SEMVER")
+		}
+		if constraint != nil && !constraint.match(version) {
+			return nil, errors.New("NOT_ALLOWED_VALUE")
+		}
+
+		return v, nil
+	}
+}
+
+func parseSemver(s string) (semver, bool) {
+	m := semverRe.FindStringSubmatch(s)
+	if m == nil {
+		return semver{}, false
+	}
+
+	var v semver
+	var err error
+	if v.major, err = strconv.ParseUint(m[1], 10, 64); err != nil {
+		return semver{}, false
+	}
+	if v.minor, err = strconv.ParseUint(m[2], 10, 64); err != nil {
+		return semver{}, false
+	}
+	if v.patch, err = strconv.ParseUint(m[3], 10, 64); err != nil {
+		return semver{}, false
+	}
+	if m[4] != "" {
+		v.pre = strings.Split(m[4], ".")
+	}
+
+	return v, true
+}
+
+// compare - compare versions by precedence, build metadata is ignored.
+func (v semver) compare(o semver) int {
+	for _, d := range [][2]uint64{{v.major, o.major}, {v.minor, o.minor}, {v.patch, o.patch}} {
+		if d[0] != d[1] {
+			if d[0] < d[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case len(v.pre) == 0 && len(o.pre) == 0:
+		return 0
+	case len(v.pre) == 0:
+		return 1
+	case len(o.pre) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.pre) && i < len(o.pre); i++ {
+		if c := comparePrerelease(v.pre[i], o.pre[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(v.pre) < len(o.pre):
+		return -1
+	case len(v.pre) > len(o.pre):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// comparePrerelease - numeric identifiers are compared numerically and have
+// lower precedence than alphanumeric ones.
+func comparePrerelease(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		if an == bn {
+			return 0
+		}
+		if an < bn {
+			return -1
+		}
+		return 1
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func parseSemverRange(s string) (semverRange, error) {
+	var r semverRange
+	for _, alternative := range strings.Split(s, "||") {
+		var set []semverComparator
+		tokens := strings.Fields(alternative)
+		for i := 0; i < len(tokens); i++ {
+			token := tokens[i]
+			// Operator can be separated from version with space, e.g. ">= 1.2.0".
+			if strings.Trim(token, "<>=~^") == "" && i+1 < len(tokens) {
+				i++
+				token += tokens[i]
+			}
+
+			m := semverComparatorRe.FindStringSubmatch(token)
+			version, ok := parseSemver(strings.TrimPrefix(m[2], "v"))
+			if !ok {
+				return nil, fmt.Errorf("invalid version %q in range %q", m[2], s)
+			}
+			set = append(set, expandComparator(m[1], version)...)
+		}
+		if len(set) == 0 {
+			return nil, fmt.Errorf("empty alternative in range %q", s)
+		}
+		r = append(r, set)
+	}
+
+	return r, nil
+}
+
+// expandComparator - replace ~ and ^ with pair of comparators.
+func expandComparator(op string, v semver) []semverComparator {
+	var upper semver
+	switch op {
+	case "~":
+		upper = semver{major: v.major, minor: v.minor + 1}
+	case "^":
+		switch {
+		case v.major > 0:
+			upper = semver{major: v.major + 1}
+		case v.minor > 0:
+			upper = semver{minor: v.minor + 1}
+		default:
+			upper = semver{patch: v.patch + 1}
+		}
+	default:
+		if op == "" {
+			op = "="
+		}
+		return []semverComparator{{op: op, version: v}}
+	}
+
+	// Upper bound excludes pre-releases of the next version.
+	upper.pre = []string{"0"}
+	return []semverComparator{{op: ">=", version: v}, {op: "<", version: upper}}
+}
+
+func (r semverRange) match(v semver) bool {
+	for _, set := range r {
+		matched := true
+		for _, c := range set {
+			if !c.match(v) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (c semverComparator) match(v semver) bool {
+	cmp := v.compare(c.version)
+	switch c.op {
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	default:
+		return cmp == 0
+	}
+}
@@ -10,7 +10,10 @@ import (
 var dateReg = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`)
 
 // isoDate - make sure that validated value is valid date in format "2006-01-02" ("YYYY-MM-DD").
+// "time" flag makes output time.Time.
 func isoDate(args ...interface{}) Validation {
+	asTime := hasFlag("time", args...)
+
 	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
 		if value == nil || value == "" {
 			return value, nil
@@ -28,11 +31,30 @@ func isoDate(args ...interface{}) Validation {
 		if t.Format("2006-01-02") != value.(string) {
 			return nil, errors.New("WRONG_DATE")
 		}
+		if asTime {
+			return t, nil
+		}
 
 		return value, nil
 	}
 }
 
+// parseDate - parse date from time.Time, RFC 3339 string or "2006-01-02" string.
+func parseDate(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t, true
+		}
+		if t, err := time.Parse("2006-01-02", v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
 var urlRe = regexp.MustCompile(
 	`(?i)^(?:(?:http|https)://)(?:\S+(?::\S*)?@)?(?:(?:(?:[1-9]\d?|1\d\d|2[0-1]\d|22[0-3])(?:\.(?:1?\d{1,2}|2[0-4]\d|25[0-5])){2}(?:\.(?:[0-9]\d?|1\d\d|2[0-4]\d|25[0-4]))|(?:(?:[a-z0-9]-*)*[a-z0-9]+)(?:\.(?:[a-z0-9]-*)*[a-z0-9]+)*(?:\.(?:[a-z]{2,})))\.?|localhost)(?::\d{2,5})?(?:[/?#]\S*)?$`,
 )
@@ -0,0 +1,70 @@
+package livr
+
+import "errors"
+
+// typedRules - rules that coerce values of other JSON types, in strict mode
+// they accept only values of expected type.
+var typedRules = map[string]func(interface{}) bool{
+	// Numeric rules.
+	"integer":          isNumber,
+	"positive_integer": isNumber,
+	"decimal":          isNumber,
+	"positive_decimal": isNumber,
+	"min_number":       isNumber,
+	"max_number":       isNumber,
+	"number_between":   isNumber,
+
+	// Boolean rules.
+	"boolean": isBool,
+
+	// Length rules.
+	"min_length":     isString,
+	"max_length":     isString,
+	"length_equal":   isString,
+	"length_between": isString,
+}
+
+func isNumber(value interface{}) bool {
+	_, ok := numberValue(value)
+	return ok
+}
+
+func isString(value interface{}) bool {
+	_, ok := value.(string)
+	return ok
+}
+
+// typeFlag - split "strict" or "coerce" flag from rule arguments.
+func typeFlag(args []interface{}) (string, []interface{}) {
+	var flag string
+	rest := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		if arg == "strict" || arg == "coerce" {
+			flag = arg.(string)
+			continue
+		}
+		rest = append(rest, arg)
+	}
+
+	return flag, rest
+}
+
+// strictBuilder - wrap builder of typed rule, so that it rejects values of
+// wrong type with FORMAT_ERROR in strict mode. "strict" and "coerce" flags
+// override the mode for single rule.
+func strictBuilder(builder Builder, typed func(interface{}) bool, strict bool) Builder {
+	return func(args ...interface{}) Validation {
+		flag, args := typeFlag(args)
+		validation := builder(args...)
+		if flag == "coerce" || (flag == "" && !strict) {
+			return validation
+		}
+
+		return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+			if value != nil && value != "" && !typed(value) {
+				return nil, errors.New("FORMAT_ERROR")
+			}
+			return validation(value, builders...)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/k33nice/go-livr"
+)
+
+// notBefore - custom rule that compares value with validated value of field.
+func notBefore(args ...interface{}) livr.Validation {
+	field, _ := args[0].(string)
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		results, _ := builders[1].(livr.Dictionary)
+		other, ok := results[field]
+		if !ok {
+			return nil, errors.New("FIELD_NOT_VALIDATED")
+		}
+		if value.(string) < other.(string) {
+			return nil, errors.New("TOO_EARLY")
+		}
+		return value, nil
+	}
+}
+
+func TestRegisterFieldRefRules(t *testing.T) {
+	v := livr.New(&livr.Options{LivrRules: livr.Dictionary{
+		"end":   []interface{}{"required", livr.Dictionary{"or": livr.Dictionary{"not_before": "start"}}},
+		"start": []interface{}{"required", "trim"},
+	}})
+	v.RegisterRules(map[string]livr.Builder{"not_before": notBefore})
+	v.RegisterFieldRefRules("not_before")
+
+	out, err := v.Validate(livr.Dictionary{"start": " 2024-01-05 ", "end": "2024-01-10"})
+	if err != nil {
+		t.Fatalf("unexpected errors %v", v.Errors())
+	}
+	if out["start"] != "2024-01-05" {
+		t.Errorf("unexpected output %v", out)
+	}
+
+	if _, err := v.Validate(livr.Dictionary{"start": "2024-01-05", "end": "2024-01-01"}); err == nil {
+		t.Fatal("expected error")
+	}
+	if code := livr.ErrorCodes(v.Errors()).(map[string]interface{})["end"]; code != "TOO_EARLY" {
+		t.Errorf("unexpected error %v", code)
+	}
+
+	// Marks of one validator don't change order of others.
+	other := livr.New(&livr.Options{LivrRules: livr.Dictionary{
+		"end":   livr.Dictionary{"not_before": "start"},
+		"start": "required",
+	}})
+	other.RegisterRules(map[string]livr.Builder{"not_before": notBefore})
+	if _, err := other.Validate(livr.Dictionary{"start": "2024-01-05", "end": "2024-01-10"}); err == nil {
+		t.Fatal("expected end to be validated before start")
+	}
+	if code := livr.ErrorCodes(other.Errors()).(map[string]interface{})["end"]; code != "FIELD_NOT_VALIDATED" {
+		t.Errorf("unexpected error %v", code)
+	}
+}
@@ -0,0 +1,53 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/k33nice/go-livr"
+)
+
+func TestLint(t *testing.T) {
+	rules := livr.Dictionary{
+		"name":     []interface{}{"requried", livr.Dictionary{"min_length": 10.0}, livr.Dictionary{"max_length": 5.0}},
+		"age":      livr.Dictionary{"number_between": []interface{}{10.0, 1.0}},
+		"code":     livr.Dictionary{"like": "[a-"},
+		"kind":     livr.Dictionary{"one_of": []interface{}{}},
+		"password": "required",
+		"confirm":  livr.Dictionary{"equal_to_field": "pasword"},
+		"address":  livr.Dictionary{"nested_object": livr.Dictionary{"zip": "positive_integr"}},
+		"email":    []interface{}{"required", "email"},
+	}
+
+	expected := map[string]string{
+		"$.name[0].requried":       "UNKNOWN_RULE",
+		"$.name":                   "CONFLICTING_RULES",
+		"$.age.number_between":     "INVALID_ARGS",
+		"$.code.like":              "INVALID_ARGS",
+		"$.kind.one_of":            "INVALID_ARGS",
+		"$.confirm.equal_to_field": "UNKNOWN_FIELD",
+		"$.address.nested_object.zip.positive_integr": "UNKNOWN_RULE",
+	}
+
+	issues := livr.Lint(rules)
+	if len(issues) != len(expected) {
+		t.Fatalf("expected %d issues, got %d: %v", len(expected), len(issues), issues)
+	}
+	for _, issue := range issues {
+		if code, ok := expected[issue.Path]; !ok || code != issue.Code {
+			t.Errorf("unexpected issue %s", issue)
+		}
+	}
+
+	if issues[0].Message != `rule "positive_integr" is not registered, did you mean "positive_integer"?` {
+		t.Errorf("unexpected suggestion: %s", issues[0].Message)
+	}
+}
+
+func TestLintEmptyChain(t *testing.T) {
+	for _, rule := range []interface{}{"not", livr.Dictionary{"not": []interface{}{}}, livr.Dictionary{"not": []interface{}{[]interface{}{}}}} {
+		issues := livr.Lint(livr.Dictionary{"a": rule})
+		if len(issues) != 1 || issues[0].Code != "INVALID_ARGS" {
+			t.Errorf("rule %v: unexpected issues %v", rule, issues)
+		}
+	}
+}
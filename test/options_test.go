@@ -0,0 +1,211 @@
+package test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/k33nice/go-livr"
+	"github.com/k33nice/go-livr/livrtest"
+)
+
+func TestEmptyString(t *testing.T) {
+	rules := livr.Dictionary{
+		"name":    "string",
+		"comment": []interface{}{"nullable", "string"},
+		"address": livr.Dictionary{"nested_object": livr.Dictionary{"zip": "string"}},
+	}
+	input := livr.Dictionary{
+		"name":    "",
+		"comment": "",
+		"address": livr.Dictionary{"zip": ""},
+	}
+
+	cases := []struct {
+		opts     *livr.Options
+		expected livr.Dictionary
+	}{
+		{
+			&livr.Options{LivrRules: rules},
+			livr.Dictionary{"name": "", "comment": "", "address": livr.Dictionary{"zip": ""}},
+		},
+		{
+			&livr.Options{LivrRules: rules, EmptyString: livr.EmptyStringNull},
+			livr.Dictionary{"name": nil, "comment": nil, "address": livr.Dictionary{"zip": nil}},
+		},
+		{
+			&livr.Options{LivrRules: rules, EmptyString: livr.EmptyStringMissing},
+			livr.Dictionary{"address": livr.Dictionary{}},
+		},
+	}
+
+	for i, c := range cases {
+		v := livr.New(c.opts)
+		out, err := v.Validate(input)
+		if err != nil {
+			t.Fatalf("case %d: unexpected errors %v", i, v.Errors())
+		}
+		if !livrtest.JSONDuckEqual(c.expected, out) {
+			t.Errorf("case %d: got %v, want %v", i, out, c.expected)
+		}
+	}
+}
+
+func TestStrict(t *testing.T) {
+	rules := livr.Dictionary{
+		"age":     "integer",
+		"name":    livr.Dictionary{"max_length": 10.0},
+		"zip":     livr.Dictionary{"positive_integer": "coerce"},
+		"address": livr.Dictionary{"nested_object": livr.Dictionary{"house": "positive_integer"}},
+		"scores":  livr.Dictionary{"list_of": "decimal"},
+		"agree":   "boolean",
+	}
+	input := livr.Dictionary{
+		"age":     "30",
+		"name":    12.0,
+		"zip":     "12345",
+		"address": livr.Dictionary{"house": "7"},
+		"scores":  []interface{}{1.5, "2.5"},
+		"agree":   "on",
+	}
+
+	v := livr.New(&livr.Options{LivrRules: rules})
+	if _, err := v.Validate(input); err != nil {
+		t.Fatalf("unexpected errors %v", v.Errors())
+	}
+
+	v = livr.New(&livr.Options{LivrRules: rules, Strict: true})
+	if _, err := v.Validate(input); err == nil {
+		t.Fatal("validation pass but must fail")
+	}
+
+	expected := livr.Dictionary{
+		"age":     "FORMAT_ERROR",
+		"name":    "FORMAT_ERROR",
+		"address": livr.Dictionary{"house": "FORMAT_ERROR"},
+		"scores":  []interface{}{nil, "FORMAT_ERROR"},
+		"agree":   "FORMAT_ERROR",
+	}
+	if errs := livr.ErrorCodes(v.Errors()); !livrtest.JSONDuckEqual(expected, errs) {
+		t.Errorf("got %v, want %v", errs, expected)
+	}
+}
+
+func TestIntegerOutput(t *testing.T) {
+	rules := livr.Dictionary{
+		"id":    "integer",
+		"count": []interface{}{"positive_integer", livr.Dictionary{"max_number": 100.0}},
+		"price": livr.Dictionary{"number_between": []interface{}{0.0, 100.0}},
+		"items": livr.Dictionary{"list_of": "integer"},
+		"code":  "integer",
+	}
+	input := livr.Dictionary{
+		"code":  "9007199254740993",
+		"id":    json.Number("9007199254740993"),
+		"count": "42",
+		"price": int64(5),
+		"items": []interface{}{1.0},
+	}
+
+	cases := []struct {
+		opts     *livr.Options
+		expected livr.Dictionary
+	}{
+		{
+			&livr.Options{LivrRules: rules},
+			livr.Dictionary{
+				"code":  9007199254740992.0,
+				"id":    json.Number("9007199254740993"),
+				"count": 42.0,
+				"price": int64(5),
+				"items": []interface{}{1.0},
+			},
+		},
+		{
+			&livr.Options{LivrRules: rules, IntegerOutput: livr.IntegerInt64},
+			livr.Dictionary{
+				"code":  int64(9007199254740993),
+				"id":    int64(9007199254740993),
+				"count": int64(42),
+				"price": int64(5),
+				"items": []interface{}{int64(1)},
+			},
+		},
+		{
+			&livr.Options{LivrRules: rules, IntegerOutput: livr.IntegerJSONNumber},
+			livr.Dictionary{
+				"code":  json.Number("9007199254740993"),
+				"id":    json.Number("9007199254740993"),
+				"count": json.Number("42"),
+				"price": int64(5),
+				"items": []interface{}{json.Number("1")},
+			},
+		},
+	}
+
+	for i, c := range cases {
+		v := livr.New(c.opts)
+		out, err := v.Validate(input)
+		if err != nil {
+			t.Fatalf("case %d: unexpected errors %v", i, v.Errors())
+		}
+		if !reflect.DeepEqual(c.expected, out) {
+			t.Errorf("case %d: got %#v, want %#v", i, out, c.expected)
+		}
+
+		_, err = v.Validate(livr.Dictionary{"id": "Inf", "code": "+Inf", "count": "1.5"})
+		if err == nil {
+			t.Fatalf("case %d: expected errors", i)
+		}
+		expected := map[string]interface{}{"id": "NOT_INTEGER", "code": "NOT_INTEGER", "count": "NOT_POSITIVE_INTEGER"}
+		if codes := livr.ErrorCodes(v.Errors()); !reflect.DeepEqual(codes, expected) {
+			t.Errorf("case %d: got errors %v, want %v", i, codes, expected)
+		}
+	}
+}
+
+func TestClock(t *testing.T) {
+	now := time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC)
+	rules := livr.Dictionary{
+		"birthday": livr.Dictionary{"max_date": "-18y"},
+		"delivery": livr.Dictionary{"date_between": []interface{}{"current", "+30d", "time"}},
+		"call":     livr.Dictionary{"min_date": "+2h"},
+		"slots":    livr.Dictionary{"list_of": livr.Dictionary{"min_date": "current"}},
+	}
+	opts := &livr.Options{LivrRules: rules, Now: func() time.Time { return now }}
+
+	v := livr.New(opts)
+	out, err := v.Validate(livr.Dictionary{
+		"birthday": "2006-02-28",
+		"delivery": "2024-03-30",
+		"call":     "2024-02-29T13:00:00+01:00",
+		"slots":    []interface{}{"2024-02-29"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected errors %v", v.Errors())
+	}
+	if delivery, ok := out["delivery"].(time.Time); !ok || !delivery.Equal(time.Date(2024, 3, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected delivery as time.Time, got %#v", out["delivery"])
+	}
+
+	v = livr.New(opts)
+	if _, err := v.Validate(livr.Dictionary{
+		"birthday": "2006-03-02",
+		"delivery": "2024-03-31",
+		"call":     "2024-02-29T12:59:59+01:00",
+		"slots":    []interface{}{"2024-02-28"},
+	}); err == nil {
+		t.Fatal("validation pass but must fail")
+	}
+
+	expected := livr.Dictionary{
+		"birthday": "TOO_LATE",
+		"delivery": "TOO_LATE",
+		"call":     "TOO_EARLY",
+		"slots":    []interface{}{"TOO_EARLY"},
+	}
+	if errs := livr.ErrorCodes(v.Errors()); !livrtest.JSONDuckEqual(expected, errs) {
+		t.Errorf("got %v, want %v", errs, expected)
+	}
+}
@@ -0,0 +1,54 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/k33nice/go-livr"
+)
+
+func TestPrepare(t *testing.T) {
+	v := livr.New(&livr.Options{LivrRules: livr.Dictionary{
+		"a": "required",
+		"b": "no_such_rule",
+	}})
+
+	if err := v.Prepare(); err == nil {
+		t.Fatal("expected error of broken rules")
+	}
+	if err := v.Prepare(); err == nil {
+		t.Fatal("expected error of broken rules on second call")
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected validation with broken rules to panic")
+		}
+	}()
+	out, err := v.Validate(livr.Dictionary{"x": 1.0})
+	t.Errorf("broken validator returned %v, %v", out, err)
+}
+
+func TestBrokenRuleArgs(t *testing.T) {
+	cases := []interface{}{
+		livr.Dictionary{"min_date": "2020-13-01"},
+		livr.Dictionary{"max_date": []interface{}{"time", "tomorrow"}},
+		livr.Dictionary{"date_between": "2020-01-01"},
+		"min_date",
+		"not",
+		livr.Dictionary{"not": []interface{}{[]interface{}{}}},
+		livr.Dictionary{"normalize_timestamp": livr.Dictionary{"zoen": "Europe/Kyiv"}},
+		livr.Dictionary{"normalize_timestamp": 3.0},
+		livr.Dictionary{"duration": livr.Dictionary{"mni": "1h"}},
+		livr.Dictionary{"duration": livr.Dictionary{"format": "hours"}},
+		livr.Dictionary{"phone": "UK"},
+		livr.Dictionary{"phone": livr.Dictionary{"regoin": "UA"}},
+		livr.Dictionary{"phone": livr.Dictionary{"region": "UA", "regions": []interface{}{"UA", "EU"}}},
+	}
+
+	for i, rule := range cases {
+		v := livr.New(&livr.Options{LivrRules: livr.Dictionary{"field": rule}})
+		if err := v.Prepare(); err == nil {
+			t.Errorf("case %d: expected error for rule %v", i, rule)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package livr
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	// Time zone database is used when system one is not available.
+	_ "time/tzdata"
+)
+
+// timestampOptions - names of "normalize_timestamp" options.
+var timestampOptions = map[string]bool{"layouts": true, "zone": true, "input_zone": true, "format": true}
+
+// timestampLayouts - named layouts of "normalize_timestamp", other layouts
+// are treated as Go time layouts.
+var timestampLayouts = map[string][]string{
+	"rfc3339": {time.RFC3339Nano},
+	"rfc1123": {time.RFC1123Z, time.RFC1123},
+	"unix":    nil,
+	"unix_ms": nil,
+}
+
+// timestampFormats - named output formats of "normalize_timestamp".
+var timestampFormats = map[string]string{
+	"rfc3339":     time.RFC3339,
+	"rfc3339nano": time.RFC3339Nano,
+}
+
+var defaultTimestampLayouts = []interface{}{"rfc3339", "rfc1123", "unix", "unix_ms"}
+
+// normalizeTimestamp - parse timestamp with one of layouts and format it in
+// target zone, e.g. {"normalize_timestamp": {"zone": "Europe/Kyiv"}}. Options:
+//   - layouts - accepted layouts: "rfc3339", "rfc1123", "unix" (seconds),
+//     "unix_ms" (milliseconds) or Go time layout, all named ones by default;
+//   - zone - IANA time zone of output, "UTC" by default;
+//   - input_zone - zone of timestamps without offset, target zone by default;
+//   - format - output format: "rfc3339" (default), "rfc3339nano" or Go layout.
+//
+// When both "unix" and "unix_ms" are accepted numbers from 1e11 are treated
+// as milliseconds. Local time that is skipped or repeated on DST change and
+// zone abbreviations other than UTC and GMT can't be resolved to a single
+// instant and fail with AMBIGUOUS_TIME.
+func normalizeTimestamp(args ...interface{}) Validation {
+	opts := Dictionary{}
+	switch v := firstArg(args...).(type) {
+	case string:
+		opts["zone"] = v
+	case Dictionary:
+		if len(v) > 0 && !isOptions(v, timestampOptions) {
+			log.Panicf("normalize_timestamp: unknown options in %v", v)
+		}
+		opts = v
+	case nil, map[string]Builder:
+	default:
+		log.Panicf("normalize_timestamp: invalid options %v", v)
+	}
+
+	zone, input, err := timestampZones(opts)
+	if err != nil {
+		log.Panicf("normalize_timestamp: %v", err)
+	}
+
+	layouts, _ := opts["layouts"].([]interface{})
+	if layouts == nil {
+		layouts = defaultTimestampLayouts
+	}
+	var unix, unixMs bool
+	var formats []string
+	for _, layout := range layouts {
+		name, _ := layout.(string)
+		switch name {
+		case "unix":
+			unix = true
+		case "unix_ms":
+			unixMs = true
+		default:
+			if named, ok := timestampLayouts[name]; ok {
+				formats = append(formats, named...)
+			} else if name != "" {
+				formats = append(formats, name)
+			}
+		}
+	}
+
+	format := time.RFC3339
+	if v, ok := opts["format"].(string); ok {
+		format = v
+		if named, ok := timestampFormats[v]; ok {
+			format = named
+		}
+	}
+
+	return func(value interface{}, builders ...interface{}) (interface{}, interface{}) {
+		if value == nil || value == "" {
+			return value, nil
+		}
+
+		var t time.Time
+		var err error
+		switch v := value.(type) {
+		case string:
+			t, err = parseTimestamp(v, formats, input)
+			if err != nil && (unix || unixMs) {
+				if n, nErr := strconv.ParseFloat(v, 64); nErr == nil {
+					t, err = unixTimestamp(n, unix, unixMs)
+				}
+			}
+		case time.Time:
+			t = v
+		default:
+			n, ok := numberValue(value)
+			if !ok {
+				return nil, errors.New("FORMAT_ERROR")
+			}
+			if number, ok := value.(json.Number); ok {
+				// Keep precision of milliseconds.
+				if i, iErr := number.Int64(); iErr == nil {
+					n = float64(i)
+				}
+			}
+			t, err = unixTimestamp(n, unix, unixMs)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return t.In(zone).Format(format), nil
+	}
+}
+
+// timestampZones - load target and input zones from options.
+func timestampZones(opts Dictionary) (*time.Location, *time.Location, error) {
+	zone := time.UTC
+	if name, ok := opts["zone"].(string); ok {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		zone = loc
+	}
+
+	input := zone
+	if name, ok := opts["input_zone"].(string); ok {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		input = loc
+	}
+
+	return zone, input, nil
+}
+
+// parseTimestamp - parse string with the first matching layout, layouts
+// without offset are parsed as local time of input zone.
+func parseTimestamp(value string, layouts []string, input *time.Location) (time.Time, error) {
+	for _, layout := range layouts {
+		switch {
+		case strings.Contains(layout, "Z07") || strings.Contains(layout, "-07"):
+			if t, err := time.Parse(layout, value); err == nil {
+				return t, nil
+			}
+		case strings.Contains(layout, "MST"):
+			t, err := time.Parse(layout, value)
+			if err != nil {
+				continue
+			}
+			if name, _ := t.Zone(); name != "UTC" && name != "GMT" {
+				return time.Time{}, errors.New("AMBIGUOUS_TIME")
+			}
+			return t, nil
+		default:
+			if t, err := time.Parse(layout, value); err == nil {
+				return localTime(t, input)
+			}
+		}
+	}
+
+	return time.Time{}, errors.New("WRONG_DATE")
+}
+
+// localTime - resolve wall clock of t in loc to a single instant.
+func localTime(t time.Time, loc *time.Location) (time.Time, error) {
+	var found []time.Time
+	for _, probe := range []time.Time{t.Add(-24 * time.Hour), t.Add(24 * time.Hour)} {
+		_, offset := time.Date(probe.Year(), probe.Month(), probe.Day(), probe.Hour(), 0, 0, 0, loc).Zone()
+		candidate := t.Add(-time.Duration(offset) * time.Second).In(loc)
+		if sameWallClock(candidate, t) && (len(found) == 0 || !found[0].Equal(candidate)) {
+			found = append(found, candidate)
+		}
+	}
+	if len(found) != 1 {
+		return time.Time{}, errors.New("AMBIGUOUS_TIME")
+	}
+
+	return found[0], nil
+}
+
+func sameWallClock(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd &&
+		a.Hour() == b.Hour() && a.Minute() == b.Minute() && a.Second() == b.Second() &&
+		a.Nanosecond() == b.Nanosecond()
+}
+
+// unixTimestamp - convert seconds or milliseconds since epoch to time.
+func unixTimestamp(n float64, unix, unixMs bool) (time.Time, error) {
+	if !unix && !unixMs {
+		return time.Time{}, errors.New("WRONG_DATE")
+	}
+	// NaN, infinity and numbers out of int64 range have no time.
+	if math.IsNaN(n) || n <= math.MinInt64 || n >= math.MaxInt64 {
+		return time.Time{}, errors.New("WRONG_DATE")
+	}
+	if unixMs && (!unix || math.Abs(n) >= 1e11) {
+		return time.UnixMilli(int64(n)).UTC(), nil
+	}
+
+	sec, frac := math.Modf(n)
+	return time.Unix(int64(sec), int64(frac*1e9)).UTC(), nil
+}